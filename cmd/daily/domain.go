@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily"
+	"github.com/lazeratops/daily-go/daily/domain"
+	"go.uber.org/zap"
+	"os"
+)
+
+// domainGet() prints the domain-wide configuration as JSON
+func domainGet(ctx context.Context, apiKey string) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	dom, err := d.GetDomainConfig(ctx)
+	if err != nil {
+		return err
+	}
+	return printDomainConfig(dom)
+}
+
+// domainSet() updates the given domain-wide configuration properties
+func domainSet(ctx context.Context, logger *zap.SugaredLogger, apiKey string, cmd DomainSetCmd) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	// Values are JSON where possible, so that
+	// e.g. "true" and "2" are not sent as strings
+	props := make(map[string]interface{}, len(cmd.Props))
+	for k, v := range cmd.Props {
+		var val interface{}
+		if err := json.Unmarshal([]byte(v), &val); err != nil {
+			val = v
+		}
+		props[k] = val
+	}
+
+	// Prepare domain properties
+	var dp domain.Props
+	data, err := json.Marshal(props)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &dp); err != nil {
+		return fmt.Errorf("invalid domain properties: %w", err)
+	}
+
+	dom, err := d.UpdateDomainConfig(ctx, domain.UpdateParams{
+		Props:           dp,
+		AdditionalProps: props,
+	})
+	if err != nil {
+		return err
+	}
+	logger.Infof("updated domain %s", dom.Name)
+	return printDomainConfig(dom)
+}
+
+// printDomainConfig() prints typed and additional domain
+// configuration properties together as a single JSON object
+func printDomainConfig(dom *domain.Domain) error {
+	data, err := json.Marshal(dom.Config)
+	if err != nil {
+		return err
+	}
+	config := make(map[string]interface{})
+	if err := json.Unmarshal(data, &config); err != nil {
+		return err
+	}
+	for k, v := range dom.AdditionalProps {
+		config[k] = v
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(map[string]interface{}{
+		"domain_name": dom.Name,
+		"domain_id":   dom.ID,
+		"config":      config,
+	})
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily"
+	"github.com/lazeratops/daily-go/daily/logs"
+	"github.com/olekukonko/tablewriter"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// logsSummary() retrieves call quality metrics and shows
+// a per-participant quality summary in a table
+func logsSummary(ctx context.Context, apiKey string, cmd LogsCmd) error {
+	if cmd.Session == "" && cmd.UserSession == "" {
+		return fmt.Errorf("at least one of --session or --user-session must be given")
+	}
+
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	metrics, err := d.GetAllCallMetrics(ctx, logs.GetParams{
+		MtgSessionID:  cmd.Session,
+		UserSessionID: cmd.UserSession,
+		StartTime:     cmd.From,
+		EndTime:       cmd.To,
+		Limit:         cmd.Limit,
+	})
+	if err != nil {
+		return err
+	}
+	if len(metrics) == 0 {
+		fmt.Println("No metrics found")
+		return nil
+	}
+
+	// Thresholds default in the flags, so an explicit 0 is kept
+	maxPacketLoss := cmd.MaxPacketLoss / 100
+	minBitrate := cmd.MinBitrate * 1000
+	summaries := logs.Summarize(metrics, &logs.SummaryOpts{
+		MaxPacketLoss:    &maxPacketLoss,
+		MaxRoundTripTime: &cmd.MaxRTT,
+		MinBitrate:       &minBitrate,
+	})
+	showQualityInTable(summaries)
+	return nil
+}
+
+// showQualityInTable() shows participant quality summaries in a
+// non-interactive ASCII table view, highlighting flagged participants
+func showQualityInTable(summaries []logs.ParticipantSummary) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetAutoWrapText(true)
+	table.SetHeader([]string{"Meeting session", "Participant session", "Samples", "Avg loss", "Max loss", "Avg RTT", "Max RTT", "Avg send kbps", "Avg recv kbps", "Issues"})
+	hc := tablewriter.Colors{tablewriter.Bold, tablewriter.BgHiCyanColor}
+	table.SetHeaderColor(hc, hc, hc, hc, hc, hc, hc, hc, hc, hc)
+
+	w1 := tablewriter.Colors{tablewriter.FgWhiteColor}
+	w2 := tablewriter.Colors{tablewriter.FgHiWhiteColor}
+	flagged := tablewriter.Colors{tablewriter.FgHiRedColor}
+
+	for i, s := range summaries {
+		// Set color to use for row
+		c := w1
+		if i%2 == 0 {
+			c = w2
+		}
+		if s.HasIssues() {
+			c = flagged
+		}
+
+		issues := make([]string, len(s.Issues))
+		for j, issue := range s.Issues {
+			issues[j] = string(issue)
+		}
+		table.Rich([]string{
+			s.MtgSessionID,
+			s.UserSessionID,
+			strconv.Itoa(s.Samples),
+			formatPercent(s.AvgPacketLoss),
+			formatPercent(s.MaxPacketLoss),
+			s.AvgRoundTripTime.Round(time.Millisecond).String(),
+			s.MaxRoundTripTime.Round(time.Millisecond).String(),
+			strconv.FormatFloat(s.AvgSendBitrate/1000, 'f', 0, 64),
+			strconv.FormatFloat(s.AvgRecvBitrate/1000, 'f', 0, 64),
+			strings.Join(issues, ", "),
+		}, []tablewriter.Colors{c, c, c, c, c, c, c, c, c, c})
+	}
+	table.Render()
+}
+
+func formatPercent(fraction float64) string {
+	return strconv.FormatFloat(fraction*100, 'f', 1, 64) + "%"
+}
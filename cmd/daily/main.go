@@ -2,8 +2,11 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"github.com/alecthomas/kong"
 	"go.uber.org/zap"
+	"os"
+	"os/signal"
 	"time"
 )
 
@@ -24,29 +27,340 @@ type RoomGetCmd struct {
 	IncludeExpired bool `help:"Include expired rooms" default:"true" negatable:""`
 }
 
+type RoomMessageCmd struct {
+	Regex string `help:"Regex to filter room names by" required:""`
+	Data  string `help:"JSON data to send as the app message" required:""`
+}
+
+type RecordingDownloadCmd struct {
+	ID    string        `help:"ID of recording to download"`
+	All   bool          `help:"Download all finished recordings"`
+	Room  string        `help:"Only download recordings of this room"`
+	Since time.Duration `help:"Only download recordings started within this duration, e.g. 24h"`
+	Dir   string        `help:"Directory to download recordings to" default:"." type:"path"`
+}
+
+type TranscriptExportCmd struct {
+	ID     string `help:"ID of transcript to export"`
+	Input  string `help:"Local WebVTT file to export instead of a Daily transcript" type:"existingfile"`
+	Format string `help:"Output format" enum:"srt,txt,json" default:"txt"`
+	Out    string `help:"File to write to instead of stdout" type:"path"`
+}
+
+type PresenceCmd struct {
+	Room string `help:"Only show participants in this room"`
+}
+
+type RoomEjectCmd struct {
+	Room    string   `help:"Room to eject participants from. If not given, the user is ejected from every room they are in"`
+	IDs     []string `name:"id" help:"Session IDs of participants to eject"`
+	UserIDs []string `name:"user-id" help:"User IDs of participants to eject"`
+}
+
+type RoomSessionDataGetCmd struct {
+	Room string `help:"Room to get session data of" required:""`
+}
+
+type RoomSessionDataSetCmd struct {
+	Room   string   `help:"Room to set session data of" required:""`
+	Data   string   `help:"JSON object to set as session data"`
+	Merge  bool     `help:"Merge the top-level keys of --data into existing session data instead of replacing it"`
+	Delete []string `help:"Top-level keys to delete from existing session data. Implies --merge"`
+}
+
+type ReportUsageCmd struct {
+	From            time.Time `help:"First day of meetings to report on, e.g. 2023-01-31" format:"2006-01-02" required:""`
+	To              time.Time `help:"Last day of meetings to report on, inclusive" format:"2006-01-02" required:""`
+	GroupBy         string    `help:"What to aggregate usage by" enum:"room,prefix,day,week" default:"room"`
+	PrefixSeparator string    `help:"When grouping by prefix, treat everything before this separator as the prefix. By default, only the random suffix of rooms created with --prefix is stripped, and other rooms are grouped by their full name"`
+	Format          string    `help:"Output format" enum:"table,csv,json" default:"table"`
+}
+
+type LogsCmd struct {
+	Session       string        `help:"Meeting session ID to summarize"`
+	UserSession   string        `help:"Participant session ID to summarize"`
+	From          time.Time     `help:"Only include metrics reported at or after this time, e.g. 2023-01-31T15:04:05Z"`
+	To            time.Time     `help:"Only include metrics reported before this time"`
+	Limit         int           `help:"Maximum number of metric samples to summarize. If 0, all samples are summarized"`
+	MaxPacketLoss float64       `help:"Flag participants with higher average packet loss, in percent" default:"5"`
+	MaxRTT        time.Duration `name:"max-rtt" help:"Flag participants with higher average round trip time" default:"400ms"`
+	MinBitrate    float64       `help:"Flag participants with lower average send bitrate, in kbps" default:"150"`
+}
+
+type DomainSetCmd struct {
+	Props map[string]string `name:"prop" help:"Domain property to set, e.g. --prop lang=de --prop hide_daily_branding=true" required:""`
+}
+
+type WebhookCreateCmd struct {
+	URL        string   `help:"URL to deliver events to" required:""`
+	EventTypes []string `name:"event-type" help:"Event types to deliver, e.g. participant.joined. If not given, all events are delivered"`
+	BasicAuth  string   `help:"Basic auth token to send with each delivery"`
+	HMAC       string   `name:"hmac" help:"Base64-encoded secret to sign deliveries with. If not given, Daily generates one"`
+	RetryType  string   `help:"How failed deliveries are retried" enum:"circuit-breaker,exponential" default:"circuit-breaker"`
+}
+
+type WebhookDeleteCmd struct {
+	ID string `help:"ID of webhook to delete" required:""`
+}
+
+type WebhookReplayCmd struct {
+	From   string `help:"Event log to replay events from" type:"existingfile" required:""`
+	To     string `help:"URL to send events to" required:""`
+	Secret string `help:"Base64-encoded webhook HMAC secret to sign events with" env:"DAILY_WEBHOOK_SECRET" required:""`
+}
+
+type WebhookSendTestCmd struct {
+	Type   string            `help:"Type of event to send, e.g. participant.joined" required:""`
+	URL    string            `help:"URL to send the event to" required:""`
+	Secret string            `help:"Base64-encoded webhook HMAC secret to sign the event with" env:"DAILY_WEBHOOK_SECRET" required:""`
+	Set    map[string]string `help:"Payload field to override, e.g. --set room=my-room --set owner=true"`
+}
+
+type WebhookListenCmd struct {
+	Port    int    `help:"Port to listen on" default:"8080"`
+	Secret  string `help:"Base64-encoded webhook HMAC secret to verify events with" env:"DAILY_WEBHOOK_SECRET" required:""`
+	Forward string `help:"URL to forward verified events to, signed with the same secret"`
+	Record  string `help:"Event log file to append received events to" type:"path"`
+}
+
+type PhoneAvailableCmd struct {
+	Region   string `help:"Two-letter state or province code to filter by, e.g. CA"`
+	AreaCode string `help:"Three-digit area code to filter by, e.g. 415"`
+}
+
+type PhoneBuyCmd struct {
+	Number string `help:"Phone number to buy, in E.164 format. If not given, any available number is bought"`
+	Yes    bool   `short:"y" help:"Buy without asking for confirmation"`
+}
+
+type PhoneReleaseCmd struct {
+	ID  string `help:"ID of purchased phone number to release" required:""`
+	Yes bool   `short:"y" help:"Release without asking for confirmation"`
+}
+
+type TokenInspectCmd struct {
+	Token  string `arg:"" help:"Meeting token, or a join link containing one"`
+	Verify bool   `help:"Also validate the token with Daily"`
+}
+
 var cli struct {
-	APIKey string `short:"a" help:"Daily API key" type:"string" env:"DAILY_API_KEY" required:""`
+	APIKey string `short:"a" help:"Daily API key. Required by commands which call the Daily API" type:"string" env:"DAILY_API_KEY"`
 	Room   struct {
-		Create RoomCreateCmd `cmd:"" help:"Create a Daily room."`
-		Get    RoomGetCmd    `cmd:"" help:"Get rooms."`
+		Create      RoomCreateCmd  `cmd:"" help:"Create a Daily room."`
+		Get         RoomGetCmd     `cmd:"" help:"Get rooms."`
+		Message     RoomMessageCmd `cmd:"" help:"Send an app message to rooms."`
+		Eject       RoomEjectCmd   `cmd:"" help:"Eject participants from rooms."`
+		SessionData struct {
+			Get RoomSessionDataGetCmd `cmd:"" help:"Show the session data of a room."`
+			Set RoomSessionDataSetCmd `cmd:"" help:"Set the session data of a room."`
+		} `cmd:"" help:"Room session data operations."`
 	} `cmd:"" help:"Daily room operations."`
+	Recording struct {
+		Download RecordingDownloadCmd `cmd:"" help:"Download recordings."`
+	} `cmd:"" help:"Daily recording operations."`
+	Transcript struct {
+		Export TranscriptExportCmd `cmd:"" help:"Export a transcript to another format."`
+	} `cmd:"" help:"Daily transcript operations."`
+	Presence PresenceCmd `cmd:"" help:"Show participants currently in rooms."`
+	Domain   struct {
+		Get struct{}     `cmd:"" help:"Show domain configuration."`
+		Set DomainSetCmd `cmd:"" help:"Update domain configuration."`
+	} `cmd:"" help:"Daily domain operations."`
+	Webhook struct {
+		List     struct{}           `cmd:"" help:"List webhooks."`
+		Create   WebhookCreateCmd   `cmd:"" help:"Create a webhook."`
+		Delete   WebhookDeleteCmd   `cmd:"" help:"Delete a webhook."`
+		Replay   WebhookReplayCmd   `cmd:"" help:"Re-send events from an event log."`
+		SendTest WebhookSendTestCmd `cmd:"" help:"Send a synthetic event."`
+		Listen   WebhookListenCmd   `cmd:"" help:"Run a local endpoint which prints received events."`
+	} `cmd:"" help:"Daily webhook operations."`
+	Phone struct {
+		Available PhoneAvailableCmd `cmd:"" help:"List phone numbers available to buy."`
+		Buy       PhoneBuyCmd       `cmd:"" help:"Buy a phone number."`
+		List      struct{}          `cmd:"" help:"List purchased phone numbers."`
+		Release   PhoneReleaseCmd   `cmd:"" help:"Release a purchased phone number."`
+	} `cmd:"" help:"Daily phone number operations."`
+	Token struct {
+		Inspect TokenInspectCmd `cmd:"" help:"Show the claims of a meeting token."`
+	} `cmd:"" help:"Daily meeting token operations."`
+	Logs   LogsCmd `cmd:"" help:"Summarize call quality of participants."`
+	Report struct {
+		Usage ReportUsageCmd `cmd:"" help:"Report meeting usage over a period of time."`
+	} `cmd:"" help:"Daily usage reports."`
+}
+
+// offlineCommands can run without an API key, since they either never
+// call the Daily API or only do so when asked to by their flags
+var offlineCommands = map[string]bool{
+	"transcript export":     true,
+	"token inspect <token>": true,
+	"webhook replay":        true,
+	"webhook send-test":     true,
+	"webhook listen":        true,
+}
+
+// checkAPIKey returns an error if the given command
+// calls the Daily API and no API key was given
+func checkAPIKey(command, apiKey string) error {
+	if apiKey == "" && !offlineCommands[command] {
+		return fmt.Errorf("%s requires an API key; set --api-key or DAILY_API_KEY", command)
+	}
+	return nil
 }
 
 func main() {
 	ctx := kong.Parse(&cli)
+	ctx.FatalIfErrorf(checkAPIKey(ctx.Command(), cli.APIKey))
 	logger, _ := zap.NewProduction()
 	sugar := logger.Sugar()
 	defer logger.Sync() // flushes buffer, if any
 	switch ctx.Command() {
 	case "room create":
 		if err := roomCreate(sugar, cli.APIKey, cli.Room.Create); err != nil {
-			sugar.Fatal("failed to create room", err)
+			sugar.Fatalf("failed to create room: %v", err)
 		}
 	case "room get":
 		getCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
 		defer cancel()
 		if err := roomGet(getCtx, sugar, cli.APIKey, cli.Room.Get); err != nil {
-			sugar.Fatal("failed to get room(s): %v", err)
+			sugar.Fatalf("failed to get room(s): %v", err)
+		}
+	case "room message":
+		msgCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := roomMessage(msgCtx, sugar, cli.APIKey, cli.Room.Message); err != nil {
+			sugar.Fatalf("failed to send app message: %v", err)
+		}
+	case "room eject":
+		ejectCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := roomEject(ejectCtx, sugar, cli.APIKey, cli.Room.Eject); err != nil {
+			sugar.Fatalf("failed to eject participants: %v", err)
+		}
+	case "room session-data get":
+		sdCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := roomSessionDataGet(sdCtx, cli.APIKey, cli.Room.SessionData.Get); err != nil {
+			sugar.Fatalf("failed to get session data: %v", err)
+		}
+	case "room session-data set":
+		sdCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := roomSessionDataSet(sdCtx, sugar, cli.APIKey, cli.Room.SessionData.Set); err != nil {
+			sugar.Fatalf("failed to set session data: %v", err)
+		}
+	case "recording download":
+		// Downloads can take a while, so only stop early on interrupt
+		dlCtx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer cancel()
+		if err := recordingDownload(dlCtx, sugar, cli.APIKey, cli.Recording.Download); err != nil {
+			sugar.Fatalf("failed to download recording(s): %v", err)
+		}
+	case "transcript export":
+		exportCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := transcriptExport(exportCtx, cli.APIKey, cli.Transcript.Export); err != nil {
+			sugar.Fatalf("failed to export transcript: %v", err)
+		}
+	case "presence":
+		presenceCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := presenceGet(presenceCtx, cli.APIKey, cli.Presence); err != nil {
+			sugar.Fatalf("failed to get presence: %v", err)
+		}
+	case "domain get":
+		domainCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := domainGet(domainCtx, cli.APIKey); err != nil {
+			sugar.Fatalf("failed to get domain configuration: %v", err)
+		}
+	case "domain set":
+		domainCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := domainSet(domainCtx, sugar, cli.APIKey, cli.Domain.Set); err != nil {
+			sugar.Fatalf("failed to update domain configuration: %v", err)
+		}
+	case "webhook list":
+		webhookCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := webhookList(webhookCtx, cli.APIKey); err != nil {
+			sugar.Fatalf("failed to list webhooks: %v", err)
+		}
+	case "webhook create":
+		webhookCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := webhookCreate(webhookCtx, sugar, cli.APIKey, cli.Webhook.Create); err != nil {
+			sugar.Fatalf("failed to create webhook: %v", err)
+		}
+	case "webhook delete":
+		webhookCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := webhookDelete(webhookCtx, sugar, cli.APIKey, cli.Webhook.Delete); err != nil {
+			sugar.Fatalf("failed to delete webhook: %v", err)
+		}
+	case "webhook replay":
+		// Replays can take a while, so only stop early on interrupt
+		replayCtx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer cancel()
+		if err := webhookReplay(replayCtx, sugar, cli.Webhook.Replay); err != nil {
+			sugar.Fatalf("failed to replay webhook events: %v", err)
+		}
+	case "webhook send-test":
+		sendCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := webhookSendTest(sendCtx, sugar, cli.Webhook.SendTest); err != nil {
+			sugar.Fatalf("failed to send test webhook event: %v", err)
+		}
+	case "webhook listen":
+		// Listen until interrupted
+		listenCtx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer cancel()
+		if err := webhookListen(listenCtx, sugar, cli.Webhook.Listen); err != nil {
+			sugar.Fatalf("failed to listen for webhook events: %v", err)
+		}
+	case "phone available":
+		phoneCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := phoneAvailable(phoneCtx, cli.APIKey, cli.Phone.Available); err != nil {
+			sugar.Fatalf("failed to list available phone numbers: %v", err)
+		}
+	case "phone buy":
+		// Confirming waits on the user, so API calls are timed individually
+		phoneCtx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer cancel()
+		if err := phoneBuy(phoneCtx, sugar, cli.APIKey, cli.Phone.Buy); err != nil {
+			sugar.Fatalf("failed to buy phone number: %v", err)
+		}
+	case "phone list":
+		phoneCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := phoneList(phoneCtx, cli.APIKey); err != nil {
+			sugar.Fatalf("failed to list purchased phone numbers: %v", err)
+		}
+	case "phone release":
+		// Confirming waits on the user, so API calls are timed individually
+		phoneCtx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer cancel()
+		if err := phoneRelease(phoneCtx, sugar, cli.APIKey, cli.Phone.Release); err != nil {
+			sugar.Fatalf("failed to release phone number: %v", err)
+		}
+	case "token inspect <token>":
+		tokenCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := tokenInspect(tokenCtx, sugar, cli.APIKey, cli.Token.Inspect); err != nil {
+			sugar.Fatalf("failed to inspect meeting token: %v", err)
+		}
+	case "logs":
+		logsCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := logsSummary(logsCtx, cli.APIKey, cli.Logs); err != nil {
+			sugar.Fatalf("failed to summarize call quality: %v", err)
+		}
+	case "report usage":
+		reportCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := reportUsage(reportCtx, cli.APIKey, cli.Report.Usage); err != nil {
+			sugar.Fatalf("failed to report usage: %v", err)
 		}
 	default:
 		panic(ctx.Command())
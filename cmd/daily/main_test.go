@@ -0,0 +1,50 @@
+package main
+
+import (
+	"github.com/alecthomas/kong"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// parseWithoutAPIKey parses the given arguments with no API
+// key set and returns the command they select
+func parseWithoutAPIKey(t *testing.T, args ...string) string {
+	t.Setenv("DAILY_API_KEY", "")
+	parser, err := kong.New(&cli)
+	require.NoError(t, err)
+	ctx, err := parser.Parse(args)
+	require.NoError(t, err)
+	require.Empty(t, cli.APIKey)
+	return ctx.Command()
+}
+
+func TestCheckAPIKey(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		command string
+		apiKey  string
+		wantErr bool
+	}{
+		{command: "room get", wantErr: true},
+		{command: "room get", apiKey: "someKey"},
+		{command: "phone buy", wantErr: true},
+		{command: "transcript export"},
+		{command: "token inspect <token>"},
+		{command: "webhook listen"},
+		{command: "webhook replay"},
+		{command: "webhook send-test"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.command, func(t *testing.T) {
+			t.Parallel()
+			err := checkAPIKey(tc.command, tc.apiKey)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily"
+	"github.com/lazeratops/daily-go/daily/phone"
+	"github.com/olekukonko/tablewriter"
+	"go.uber.org/zap"
+	"os"
+	"time"
+)
+
+// phoneCallTimeout is how long each API call of a phone command which
+// asks for confirmation may take. Calls are timed individually, so
+// waiting for the user to confirm does not use up the deadline.
+const phoneCallTimeout = time.Minute
+
+// phoneAvailable() shows phone numbers available to buy in a table
+func phoneAvailable(ctx context.Context, apiKey string, cmd PhoneAvailableCmd) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	numbers, err := d.GetAvailablePhoneNumbers(ctx, &phone.ListAvailableParams{
+		Region:   cmd.Region,
+		AreaCode: cmd.AreaCode,
+	})
+	if err != nil {
+		return err
+	}
+	if len(numbers) == 0 {
+		fmt.Println("No available phone numbers found")
+		return nil
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetAutoWrapText(true)
+	table.SetHeader([]string{"Number", "Region", "City"})
+	hc := tablewriter.Colors{tablewriter.Bold, tablewriter.BgHiCyanColor}
+	table.SetHeaderColor(hc, hc, hc)
+
+	w1 := tablewriter.Colors{tablewriter.FgWhiteColor}
+	w2 := tablewriter.Colors{tablewriter.FgHiWhiteColor}
+
+	for i, n := range numbers {
+		// Set color to use for row
+		c := w1
+		if i%2 == 0 {
+			c = w2
+		}
+		table.Rich([]string{n.Number, n.Region, n.City}, []tablewriter.Colors{c, c, c})
+	}
+	table.Render()
+	return nil
+}
+
+// phoneBuy() buys a phone number after confirming with the user
+func phoneBuy(ctx context.Context, logger *zap.SugaredLogger, apiKey string, cmd PhoneBuyCmd) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Yes {
+		label := "Buy any available phone number"
+		if cmd.Number != "" {
+			label = fmt.Sprintf("Buy phone number %s", cmd.Number)
+		}
+		ok, err := confirm(label)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			logger.Info("not buying phone number")
+			return nil
+		}
+	}
+
+	buyCtx, cancel := context.WithTimeout(ctx, phoneCallTimeout)
+	defer cancel()
+	n, err := d.BuyPhoneNumber(buyCtx, cmd.Number)
+	if err != nil {
+		return err
+	}
+	logger.Infof("bought phone number %s with ID %s", n.Number, n.ID)
+	return nil
+}
+
+// phoneList() shows purchased phone numbers in a table
+func phoneList(ctx context.Context, apiKey string) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	numbers, err := d.GetPurchasedPhoneNumbers(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if len(numbers) == 0 {
+		fmt.Println("No purchased phone numbers found")
+		return nil
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetAutoWrapText(true)
+	table.SetHeader([]string{"ID", "Number", "Name", "Type", "Created"})
+	hc := tablewriter.Colors{tablewriter.Bold, tablewriter.BgHiCyanColor}
+	table.SetHeaderColor(hc, hc, hc, hc, hc)
+
+	w1 := tablewriter.Colors{tablewriter.FgWhiteColor}
+	w2 := tablewriter.Colors{tablewriter.FgHiWhiteColor}
+
+	for i, n := range numbers {
+		// Set color to use for row
+		c := w1
+		if i%2 == 0 {
+			c = w2
+		}
+		table.Rich([]string{n.ID, n.Number, n.Name, n.Type, n.CreatedDate}, []tablewriter.Colors{c, c, c, c, c})
+	}
+	table.Render()
+	return nil
+}
+
+// phoneRelease() releases a purchased phone number
+// after confirming with the user
+func phoneRelease(ctx context.Context, logger *zap.SugaredLogger, apiKey string, cmd PhoneReleaseCmd) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Yes {
+		// Show the number itself, so the user can
+		// tell they are releasing the right one
+		listCtx, cancel := context.WithTimeout(ctx, phoneCallTimeout)
+		numbers, err := d.GetPurchasedPhoneNumbers(listCtx, nil)
+		cancel()
+		if err != nil {
+			return err
+		}
+		var number string
+		for _, n := range numbers {
+			if n.ID == cmd.ID {
+				number = n.Number
+				break
+			}
+		}
+		if number == "" {
+			return fmt.Errorf("no purchased phone number with ID %s", cmd.ID)
+		}
+
+		ok, err := confirm(fmt.Sprintf("Release phone number %s", number))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			logger.Info("not releasing phone number")
+			return nil
+		}
+	}
+
+	releaseCtx, cancel := context.WithTimeout(ctx, phoneCallTimeout)
+	defer cancel()
+	if err := d.ReleasePhoneNumber(releaseCtx, cmd.ID); err != nil {
+		return err
+	}
+	logger.Infof("released phone number %s", cmd.ID)
+	return nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily"
+	"github.com/lazeratops/daily-go/daily/presence"
+	"github.com/olekukonko/tablewriter"
+	"os"
+	"sort"
+)
+
+// presenceGet() shows participants currently present in
+// all rooms, or in the given room, in a table
+func presenceGet(ctx context.Context, apiKey string, cmd PresenceCmd) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	var participants []presence.Participant
+	if cmd.Room != "" {
+		participants, err = d.GetRoomPresence(ctx, cmd.Room)
+		if err != nil {
+			return err
+		}
+	} else {
+		p, err := d.GetPresence(ctx)
+		if err != nil {
+			return err
+		}
+		for _, roomParticipants := range p {
+			participants = append(participants, roomParticipants...)
+		}
+	}
+
+	// Group participants by room, earliest joiners first
+	sort.Slice(participants, func(i, j int) bool {
+		if participants[i].Room != participants[j].Room {
+			return participants[i].Room < participants[j].Room
+		}
+		return participants[i].JoinTime.Before(participants[j].JoinTime)
+	})
+	showPresenceInTable(participants)
+	return nil
+}
+
+// showPresenceInTable() shows participants in a non-interactive ASCII table view
+func showPresenceInTable(participants []presence.Participant) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetAutoWrapText(true)
+	table.SetHeader([]string{"Room", "User name", "User ID", "Session ID", "Joined at", "Duration"})
+	hc := tablewriter.Colors{tablewriter.Bold, tablewriter.BgHiCyanColor}
+	table.SetHeaderColor(hc, hc, hc, hc, hc, hc)
+
+	w1 := tablewriter.Colors{tablewriter.FgWhiteColor}
+	w2 := tablewriter.Colors{tablewriter.FgHiWhiteColor}
+
+	for i, p := range participants {
+		// Set color to use for row
+		c := w1
+		if i%2 == 0 {
+			c = w2
+		}
+
+		table.Rich([]string{p.Room, p.UserName, p.UserID, p.ID, p.JoinTime.String(), p.GetDuration().String()}, []tablewriter.Colors{c, c, c, c, c, c})
+	}
+	table.Render()
+}
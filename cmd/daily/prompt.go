@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/lazeratops/daily-go/daily"
 	"github.com/lazeratops/daily-go/daily/room"
@@ -133,3 +134,19 @@ func roomItemsToRooms(items []*roomItem, rooms []room.Room) []room.Room {
 	}
 	return retRooms
 }
+
+// confirm() asks the user a yes/no question and
+// reports whether they answered yes
+func confirm(label string) (bool, error) {
+	prompt := promptui.Prompt{
+		Label:     label,
+		IsConfirm: true,
+	}
+	if _, err := prompt.Run(); err != nil {
+		if errors.Is(err, promptui.ErrAbort) {
+			return false, nil
+		}
+		return false, fmt.Errorf("prompt failed: %w", err)
+	}
+	return true, nil
+}
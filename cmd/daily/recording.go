@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily"
+	"github.com/lazeratops/daily-go/daily/recording"
+	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// recordingDownload() downloads the relevant recording(s) to the given directory
+func recordingDownload(ctx context.Context, logger *zap.SugaredLogger, apiKey string, cmd RecordingDownloadCmd) error {
+	if cmd.ID == "" && !cmd.All {
+		return errors.New("either a recording ID or --all must be given")
+	}
+
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(cmd.Dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create download directory: %w", err)
+	}
+
+	// If ID is provided, just download single
+	// recording by that ID
+	if cmd.ID != "" {
+		r, err := d.GetRecording(ctx, cmd.ID)
+		if err != nil {
+			return err
+		}
+		return downloadRecording(ctx, logger, d, *r, cmd.Dir)
+	}
+
+	recordings, err := d.GetRecordings(ctx, &recording.GetManyParams{
+		RoomName: cmd.Room,
+	})
+	if err != nil {
+		return err
+	}
+
+	var downloaded int
+	for _, r := range recordings {
+		if r.Status != recording.StatusFinished {
+			continue
+		}
+		if cmd.Since > 0 && r.GetStartTime().Before(time.Now().Add(-cmd.Since)) {
+			continue
+		}
+		if err := downloadRecording(ctx, logger, d, r, cmd.Dir); err != nil {
+			return err
+		}
+		downloaded++
+	}
+	logger.Infof("downloaded %d recordings to %s", downloaded, cmd.Dir)
+	return nil
+}
+
+// downloadRecording() downloads a single recording, logging progress
+func downloadRecording(ctx context.Context, logger *zap.SugaredLogger, d *daily.Daily, r recording.Recording, dir string) error {
+	link, err := d.GetRecordingAccessLink(ctx, r.ID, 0)
+	if err != nil {
+		return err
+	}
+
+	dst := filepath.Join(dir, fmt.Sprintf("%s-%s%s", r.RoomName, r.ID, r.GetFileExtension()))
+	logger.Infof("downloading recording '%s' of room '%s' to %s", r.ID, r.RoomName, dst)
+
+	// Log every 10% of progress
+	var lastPercent int64 = -1
+	return recording.Download(ctx, link.DownloadLink, dst, &recording.DownloadOpts{
+		OnProgress: func(downloaded, total int64) {
+			if total <= 0 {
+				return
+			}
+			percent := downloaded * 100 / total
+			if percent/10 > lastPercent/10 {
+				lastPercent = percent
+				logger.Infof("recording '%s': %d%% (%d/%d bytes)", r.ID, percent, downloaded, total)
+			}
+		},
+	})
+}
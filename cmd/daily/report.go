@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily"
+	"github.com/lazeratops/daily-go/daily/report"
+	"github.com/olekukonko/tablewriter"
+	"os"
+	"strings"
+	"time"
+)
+
+// reportUsage() aggregates meeting usage between the given
+// dates and shows it in the given format
+func reportUsage(ctx context.Context, apiKey string, cmd ReportUsageCmd) error {
+	// The end date is inclusive, so count up to the end of that day
+	to := cmd.To.Add(24 * time.Hour)
+	if !to.After(cmd.From) {
+		return fmt.Errorf("--to (%s) must not be before --from (%s)", cmd.To.Format("2006-01-02"), cmd.From.Format("2006-01-02"))
+	}
+
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	opts := report.UsageOpts{
+		GroupBy: report.GroupBy(cmd.GroupBy),
+	}
+	if sep := cmd.PrefixSeparator; sep != "" {
+		opts.Prefix = func(roomName string) string {
+			prefix, _, _ := strings.Cut(roomName, sep)
+			return prefix
+		}
+	}
+
+	usages, err := d.GetUsageReport(ctx, cmd.From, to, opts)
+	if err != nil {
+		return err
+	}
+
+	switch cmd.Format {
+	case "csv":
+		return report.WriteCSV(os.Stdout, usages)
+	case "json":
+		return report.WriteJSON(os.Stdout, usages)
+	}
+	showUsageInTable(usages)
+	return nil
+}
+
+// showUsageInTable() shows usage in a non-interactive ASCII table view
+func showUsageInTable(usages []report.Usage) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetAutoWrapText(true)
+	table.SetHeader(report.UsageHeader())
+	hc := tablewriter.Colors{tablewriter.Bold, tablewriter.BgHiCyanColor}
+	table.SetHeaderColor(hc, hc, hc, hc, hc)
+
+	w1 := tablewriter.Colors{tablewriter.FgWhiteColor}
+	w2 := tablewriter.Colors{tablewriter.FgHiWhiteColor}
+
+	for i, u := range usages {
+		// Set color to use for row
+		c := w1
+		if i%2 == 0 {
+			c = w2
+		}
+
+		table.Rich(report.UsageRow(u), []tablewriter.Colors{c, c, c, c, c})
+	}
+	table.Render()
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/lazeratops/daily-go/daily"
 	"github.com/lazeratops/daily-go/daily/room"
@@ -139,6 +140,94 @@ func roomGetSingle(ctx context.Context, logger *zap.SugaredLogger, cmd RoomGetCm
 	return nil
 }
 
+// roomMessage() sends an app message to all rooms matching the given regex
+func roomMessage(ctx context.Context, logger *zap.SugaredLogger, apiKey string, cmd RoomMessageCmd) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	reg, err := regexp.Compile(cmd.Regex)
+	if err != nil {
+		return fmt.Errorf("invalid regex: %w", err)
+	}
+
+	if !json.Valid([]byte(cmd.Data)) {
+		return fmt.Errorf("data must be valid JSON: %s", cmd.Data)
+	}
+
+	results, err := d.BroadcastAppMessage(ctx, reg, json.RawMessage(cmd.Data))
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for _, res := range results {
+		if res.Err != nil {
+			failed++
+			logger.Errorf("failed to send app message to room '%s': %v", res.RoomName, res.Err)
+			continue
+		}
+		logger.Infof("sent app message to room '%s'", res.RoomName)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to send app message to %d of %d rooms", failed, len(results))
+	}
+	logger.Infof("sent app message to %d rooms", len(results))
+	return nil
+}
+
+// roomEject() ejects participants from the given room, or
+// ejects a user from every room they are present in
+func roomEject(ctx context.Context, logger *zap.SugaredLogger, apiKey string, cmd RoomEjectCmd) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Room != "" {
+		ejected, err := d.EjectParticipants(ctx, cmd.Room, room.EjectParams{
+			IDs:     cmd.IDs,
+			UserIDs: cmd.UserIDs,
+		})
+		if err != nil {
+			return err
+		}
+		logger.Infof("ejected %d participants from room '%s': %s", len(ejected), cmd.Room, strings.Join(ejected, ", "))
+		return nil
+	}
+
+	// Without a room, eject a single user from everywhere they are present
+	if len(cmd.IDs) > 0 || len(cmd.UserIDs) != 1 {
+		return errors.New("without --room, exactly one --user-id and no --id must be given")
+	}
+	userID := cmd.UserIDs[0]
+	results, err := d.EjectUserFromAllRooms(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		logger.Infof("user '%s' is not present in any room", userID)
+		return nil
+	}
+
+	var failed int
+	for _, res := range results {
+		if res.Err != nil {
+			failed++
+			logger.Errorf("failed to eject user '%s' from room '%s': %v", userID, res.RoomName, res.Err)
+			continue
+		}
+		logger.Infof("ejected user '%s' from room '%s'", userID, res.RoomName)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to eject user from %d of %d rooms", failed, len(results))
+	}
+	return nil
+}
+
 // showInTable() shows rooms in a non-interactive ASCII table view
 func showInTable(rooms []room.Room) error {
 	table := tablewriter.NewWriter(os.Stdout)
@@ -194,3 +283,56 @@ func deleteRooms(ctx context.Context, logger *zap.SugaredLogger, rooms []room.Ro
 func removeRoom(rooms []room.Room, idx int) []room.Room {
 	return append(rooms[:idx], rooms[idx+1:]...)
 }
+
+// roomSessionDataGet() prints the session data of the given room as JSON
+func roomSessionDataGet(ctx context.Context, apiKey string, cmd RoomSessionDataGetCmd) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	var data interface{}
+	if err := d.GetSessionData(ctx, cmd.Room, &data); err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
+
+// roomSessionDataSet() replaces, merges into or deletes keys
+// from the session data of the given room
+func roomSessionDataSet(ctx context.Context, logger *zap.SugaredLogger, apiKey string, cmd RoomSessionDataSetCmd) error {
+	if cmd.Data == "" && len(cmd.Delete) == 0 {
+		return errors.New("at least one of --data or --delete must be given")
+	}
+
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	var data map[string]interface{}
+	if cmd.Data != "" {
+		if err := json.Unmarshal([]byte(cmd.Data), &data); err != nil {
+			return fmt.Errorf("data must be a JSON object: %w", err)
+		}
+	}
+
+	// Deleting keys only makes sense when merging
+	if cmd.Merge || len(cmd.Delete) > 0 {
+		if err := d.PatchSessionData(ctx, cmd.Room, data, cmd.Delete); err != nil {
+			return err
+		}
+		logger.Infof("patched session data of room '%s'", cmd.Room)
+		return nil
+	}
+
+	if err := d.SetSessionData(ctx, cmd.Room, data, nil); err != nil {
+		return err
+	}
+	logger.Infof("replaced session data of room '%s'", cmd.Room)
+	return nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily"
+	"github.com/lazeratops/daily-go/daily/token"
+	"github.com/olekukonko/tablewriter"
+	"go.uber.org/zap"
+	"os"
+	"strings"
+	"time"
+)
+
+// tokenInspect() shows the claims of a meeting token in a table,
+// optionally validating the token with Daily first
+func tokenInspect(ctx context.Context, logger *zap.SugaredLogger, apiKey string, cmd TokenInspectCmd) error {
+	tok := cmd.Token
+	if strings.Contains(tok, "://") {
+		var err error
+		tok, err = token.FromJoinLink(tok)
+		if err != nil {
+			return err
+		}
+	}
+
+	claims, err := token.Decode(tok)
+	if err != nil {
+		return err
+	}
+	if !cmd.Verify {
+		logger.Info("token signature was not verified; use --verify to validate the token with Daily")
+		return showClaimsInTable(claims)
+	}
+
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	verified, err := d.GetMeetingToken(ctx, tok)
+	if err != nil {
+		// Still show what the token claims, to
+		// help tell why it was not accepted
+		if err := showClaimsInTable(claims); err != nil {
+			return err
+		}
+		return err
+	}
+	logger.Info("token is valid")
+	return showClaimsInTable(verified)
+}
+
+// showClaimsInTable() shows meeting token claims in a non-interactive
+// ASCII table view, highlighting the expiry of expired tokens
+func showClaimsInTable(claims *token.Claims) error {
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return fmt.Errorf("failed to marshal claims: %w", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("failed to unmarshal claims: %w", err)
+	}
+	delete(fields, "AdditionalProps")
+	for k, v := range claims.AdditionalProps {
+		fields[k] = v
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetAutoWrapText(true)
+	table.SetHeader([]string{"Field", "Value"})
+	hc := tablewriter.Colors{tablewriter.Bold, tablewriter.BgHiCyanColor}
+	table.SetHeaderColor(hc, hc)
+
+	w1 := tablewriter.Colors{tablewriter.FgWhiteColor}
+	w2 := tablewriter.Colors{tablewriter.FgHiWhiteColor}
+	expired := tablewriter.Colors{tablewriter.FgHiRedColor}
+
+	for i, row := range flattenFields("", fields) {
+		// Set color to use for row
+		c := w1
+		if i%2 == 0 {
+			c = w2
+		}
+
+		// Show timestamps in a readable form as well
+		switch row[0] {
+		case "exp":
+			row[1] = fmt.Sprintf("%s (%s)", row[1], claims.GetExpiry().Format(time.RFC3339))
+			if claims.IsExpired(time.Now()) {
+				c = expired
+			}
+		case "nbf":
+			row[1] = fmt.Sprintf("%s (%s)", row[1], claims.GetNotBefore().Format(time.RFC3339))
+		}
+		table.Rich(row, []tablewriter.Colors{c, c})
+	}
+	table.Render()
+	return nil
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"github.com/lazeratops/daily-go/daily"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestTokenInspectWithoutAPIKey(t *testing.T) {
+	enc := base64.RawURLEncoding
+	tok := enc.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + enc.EncodeToString([]byte(`{"r":"some-room","exp":1700000000}`)) + ".c2lnbmF0dXJl"
+	logger := zap.NewNop().Sugar()
+
+	// Decoding offline must not need an API key
+	require.NoError(t, tokenInspect(context.Background(), logger, "", TokenInspectCmd{Token: tok}))
+	require.NoError(t, tokenInspect(context.Background(), logger, "", TokenInspectCmd{Token: "https://your-domain.daily.co/some-room?t=" + tok}))
+
+	// Verifying with Daily does
+	err := tokenInspect(context.Background(), logger, "", TokenInspectCmd{Token: tok, Verify: true})
+	require.ErrorIs(t, err, daily.ErrInvalidAPIKey)
+}
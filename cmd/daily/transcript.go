@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily"
+	"github.com/lazeratops/daily-go/daily/transcript"
+	"io"
+	"os"
+)
+
+// transcriptExport() converts a Daily or local WebVTT transcript to the given format
+func transcriptExport(ctx context.Context, apiKey string, cmd TranscriptExportCmd) error {
+	if (cmd.ID == "") == (cmd.Input == "") {
+		return errors.New("exactly one of a transcript ID or --input must be given")
+	}
+
+	var cues []transcript.Cue
+	if cmd.Input != "" {
+		f, err := os.Open(cmd.Input)
+		if err != nil {
+			return fmt.Errorf("failed to open input file: %w", err)
+		}
+		defer f.Close()
+		cues, err = transcript.ParseVTT(f)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Init Daily with given API key
+		d, err := daily.NewDaily(apiKey)
+		if err != nil {
+			return err
+		}
+		cues, err = d.GetTranscriptCues(ctx, cmd.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	var w io.Writer = os.Stdout
+	if cmd.Out != "" {
+		f, err := os.Create(cmd.Out)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	return transcript.Export(w, cues, transcript.Format(cmd.Format))
+}
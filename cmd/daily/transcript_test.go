@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTranscriptExportWithoutAPIKey(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "transcript.vtt")
+	out := filepath.Join(dir, "transcript.srt")
+	require.NoError(t, os.WriteFile(input, []byte("WEBVTT\n\n00:00:01.000 --> 00:00:02.500\n<v Alice>Hello there\n"), 0o644))
+
+	require.Equal(t, "transcript export", parseWithoutAPIKey(t, "transcript", "export", "--input", input, "--format", "srt"))
+
+	require.NoError(t, transcriptExport(context.Background(), "", TranscriptExportCmd{
+		Input:  input,
+		Format: "srt",
+		Out:    out,
+	}))
+	got, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Equal(t, "1\n00:00:01,000 --> 00:00:02,500\nAlice: Hello there\n", string(got))
+}
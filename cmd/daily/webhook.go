@@ -0,0 +1,312 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily"
+	"github.com/lazeratops/daily-go/daily/webhook"
+	"github.com/olekukonko/tablewriter"
+	"go.uber.org/zap"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// webhookList() shows all webhooks in a table
+func webhookList(ctx context.Context, apiKey string) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := d.GetWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		fmt.Println("No webhooks found")
+		return nil
+	}
+	showWebhooksInTable(webhooks)
+	return nil
+}
+
+// webhookCreate() creates a webhook and shows it in a table
+func webhookCreate(ctx context.Context, logger *zap.SugaredLogger, apiKey string, cmd WebhookCreateCmd) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	eventTypes := make([]webhook.EventType, len(cmd.EventTypes))
+	for i, et := range cmd.EventTypes {
+		eventTypes[i] = webhook.EventType(et)
+	}
+	w, err := d.CreateWebhook(ctx, webhook.CreateParams{
+		URL:        cmd.URL,
+		EventTypes: eventTypes,
+		BasicAuth:  cmd.BasicAuth,
+		HMAC:       cmd.HMAC,
+		RetryType:  webhook.RetryType(cmd.RetryType),
+	})
+	if err != nil {
+		return err
+	}
+	logger.Infof("created webhook %s", w.ID)
+	showWebhooksInTable([]webhook.Webhook{*w})
+	// Show the secret to the operator only, keeping it out of the logs
+	if w.HMAC != "" {
+		fmt.Printf("HMAC secret: %s\n", w.HMAC)
+	}
+	return nil
+}
+
+// webhookDelete() deletes the webhook with the given ID
+func webhookDelete(ctx context.Context, logger *zap.SugaredLogger, apiKey string, cmd WebhookDeleteCmd) error {
+	// Init Daily with given API key
+	d, err := daily.NewDaily(apiKey)
+	if err != nil {
+		return err
+	}
+
+	if err := d.DeleteWebhook(ctx, cmd.ID); err != nil {
+		return err
+	}
+	logger.Infof("deleted webhook %s", cmd.ID)
+	return nil
+}
+
+// showWebhooksInTable() shows webhooks in a non-interactive
+// ASCII table view, highlighting failed webhooks
+func showWebhooksInTable(webhooks []webhook.Webhook) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetAutoWrapText(true)
+	table.SetHeader([]string{"ID", "URL", "State", "Event types", "Retry type", "Failed count", "Created at"})
+	hc := tablewriter.Colors{tablewriter.Bold, tablewriter.BgHiCyanColor}
+	table.SetHeaderColor(hc, hc, hc, hc, hc, hc, hc)
+
+	w1 := tablewriter.Colors{tablewriter.FgWhiteColor}
+	w2 := tablewriter.Colors{tablewriter.FgHiWhiteColor}
+	failed := tablewriter.Colors{tablewriter.FgHiRedColor}
+
+	for i, w := range webhooks {
+		// Set color to use for row
+		c := w1
+		if i%2 == 0 {
+			c = w2
+		}
+		if w.State == webhook.StateFailed {
+			c = failed
+		}
+
+		eventTypes := "all"
+		if len(w.EventTypes) > 0 {
+			types := make([]string, len(w.EventTypes))
+			for j, et := range w.EventTypes {
+				types[j] = string(et)
+			}
+			eventTypes = strings.Join(types, ", ")
+		}
+		table.Rich([]string{w.ID, w.URL, string(w.State), eventTypes, string(w.RetryType), strconv.Itoa(w.FailedCount), w.CreatedAt.String()}, []tablewriter.Colors{c, c, c, c, c, c, c})
+	}
+	table.Render()
+}
+
+// webhookReplay() re-sends events stored in an event log to the given URL,
+// signed with the given secret as Daily would sign them
+func webhookReplay(ctx context.Context, logger *zap.SugaredLogger, cmd WebhookReplayCmd) error {
+	f, err := os.Open(cmd.From)
+	if err != nil {
+		return fmt.Errorf("failed to open event log: %w", err)
+	}
+	defer f.Close()
+
+	records, err := webhook.ReadEventLog(f)
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for _, rec := range records {
+		e, err := rec.Event()
+		if err != nil {
+			return err
+		}
+		if err := webhook.Send(ctx, cmd.To, cmd.Secret, rec.Body); err != nil {
+			failed++
+			logger.Errorf("failed to replay %s event %s: %v", e.Type, e.ID, err)
+			continue
+		}
+		logger.Infof("replayed %s event %s received at %s", e.Type, e.ID, rec.ReceivedAt)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d events failed to replay", failed, len(records))
+	}
+	return nil
+}
+
+// webhookSendTest() sends a signed synthetic event of the given type to the given URL
+func webhookSendTest(ctx context.Context, logger *zap.SugaredLogger, cmd WebhookSendTestCmd) error {
+	// Values are JSON where possible, so that
+	// e.g. "true" and "2" are not sent as strings
+	overrides := make(map[string]interface{}, len(cmd.Set))
+	for k, v := range cmd.Set {
+		var val interface{}
+		if err := json.Unmarshal([]byte(v), &val); err != nil {
+			val = v
+		}
+		overrides[k] = val
+	}
+
+	e, err := webhook.NewTestEvent(webhook.EventType(cmd.Type), overrides)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to marshal test event: %w", err)
+	}
+	if err := webhook.Send(ctx, cmd.URL, cmd.Secret, body); err != nil {
+		return err
+	}
+	logger.Infof("sent %s event %s: %s", e.Type, e.ID, string(body))
+	return nil
+}
+
+// webhookListen() runs a local verifying webhook endpoint which prints
+// each event it receives, and optionally forwards and records them
+func webhookListen(ctx context.Context, logger *zap.SugaredLogger, cmd WebhookListenCmd) error {
+	opts := &webhook.HandlerOpts{
+		OnError: func(err error) {
+			logger.Warnf("rejected delivery: %v", err)
+		},
+	}
+	if cmd.Record != "" {
+		eventLog, err := webhook.OpenEventLog(cmd.Record)
+		if err != nil {
+			return err
+		}
+		defer eventLog.Close()
+		opts.EventLog = eventLog
+	}
+
+	h, err := webhook.NewHandler(cmd.Secret, opts)
+	if err != nil {
+		return err
+	}
+
+	// Deliveries may arrive concurrently, so
+	// don't let their output interleave
+	var mu sync.Mutex
+	h.OnEvent(func(ctx context.Context, e webhook.Event) error {
+		mu.Lock()
+		showEventInTable(e)
+		mu.Unlock()
+
+		if cmd.Forward != "" {
+			// Forward the delivery exactly as received, so
+			// fields the Event type doesn't know survive
+			body, ok := webhook.RawBody(ctx)
+			if !ok {
+				return fmt.Errorf("no delivery body to forward %s event %s", e.Type, e.ID)
+			}
+			// A failing forward target shouldn't have Daily retry
+			if err := webhook.Send(ctx, cmd.Forward, cmd.Secret, body); err != nil {
+				logger.Warnf("failed to forward %s event %s: %v", e.Type, e.ID, err)
+			}
+		}
+		return nil
+	})
+
+	srv := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cmd.Port),
+		Handler:           h,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.ListenAndServe()
+	}()
+	logger.Infof("listening for webhook events on %s", srv.Addr)
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("failed to listen: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return srv.Shutdown(shutdownCtx)
+}
+
+// showEventInTable() shows a webhook event and its payload
+// fields in a non-interactive ASCII table view
+func showEventInTable(e webhook.Event) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetAutoWrapText(true)
+	table.SetHeader([]string{"Field", "Value"})
+	hc := tablewriter.Colors{tablewriter.Bold, tablewriter.BgHiCyanColor}
+	table.SetHeaderColor(hc, hc)
+
+	w1 := tablewriter.Colors{tablewriter.FgWhiteColor}
+	w2 := tablewriter.Colors{tablewriter.FgHiWhiteColor}
+
+	rows := [][]string{
+		{"type", string(e.Type)},
+		{"id", e.ID},
+		{"event_ts", e.Time.Format(time.RFC3339Nano)},
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(e.Payload, &payload); err == nil {
+		rows = append(rows, flattenFields("", payload)...)
+	} else {
+		rows = append(rows, []string{"payload", string(e.Payload)})
+	}
+
+	for i, row := range rows {
+		// Set color to use for row
+		c := w1
+		if i%2 == 0 {
+			c = w2
+		}
+
+		table.Rich(row, []tablewriter.Colors{c, c})
+	}
+	table.Render()
+}
+
+// flattenFields() returns key-value rows for the given fields, sorted by
+// key, with nested objects flattened into dot-separated keys
+func flattenFields(prefix string, fields map[string]interface{}) [][]string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var rows [][]string
+	for _, k := range keys {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		switch v := fields[k].(type) {
+		case map[string]interface{}:
+			rows = append(rows, flattenFields(key, v)...)
+		case string:
+			rows = append(rows, []string{key, v})
+		default:
+			data, _ := json.Marshal(v)
+			rows = append(rows, []string{key, string(data)})
+		}
+	}
+	return rows
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/webhook"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testSecret = "c2VjcmV0LWZvci10ZXN0aW5nLXdlYmhvb2tz"
+
+func TestWebhookCommandsWithoutAPIKey(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "events.jsonl")
+	require.NoError(t, os.WriteFile(logPath, nil, 0o644))
+
+	require.Equal(t, "webhook send-test", parseWithoutAPIKey(t, "webhook", "send-test", "--type", "meeting.started", "--url", "http://localhost", "--secret", testSecret))
+	require.Equal(t, "webhook replay", parseWithoutAPIKey(t, "webhook", "replay", "--from", logPath, "--to", "http://localhost", "--secret", testSecret))
+	require.Equal(t, "webhook listen", parseWithoutAPIKey(t, "webhook", "listen", "--secret", testSecret))
+}
+
+func TestWebhookSendTestAndReplay(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "events.jsonl")
+	eventLog, err := webhook.OpenEventLog(logPath)
+	require.NoError(t, err)
+
+	var recorded, replayed []webhook.EventType
+	recorder, err := webhook.NewHandler(testSecret, &webhook.HandlerOpts{EventLog: eventLog})
+	require.NoError(t, err)
+	recorder.OnEvent(func(ctx context.Context, e webhook.Event) error {
+		recorded = append(recorded, e.Type)
+		return nil
+	})
+	recordServer := httptest.NewServer(recorder)
+	defer recordServer.Close()
+
+	receiver, err := webhook.NewHandler(testSecret, nil)
+	require.NoError(t, err)
+	receiver.OnEvent(func(ctx context.Context, e webhook.Event) error {
+		replayed = append(replayed, e.Type)
+		return nil
+	})
+	receiveServer := httptest.NewServer(receiver)
+	defer receiveServer.Close()
+
+	logger := zap.NewNop().Sugar()
+	require.NoError(t, webhookSendTest(context.Background(), logger, WebhookSendTestCmd{
+		Type:   string(webhook.EventMeetingStarted),
+		URL:    recordServer.URL,
+		Secret: testSecret,
+	}))
+	require.NoError(t, eventLog.Close())
+	require.Equal(t, []webhook.EventType{webhook.EventMeetingStarted}, recorded)
+
+	require.NoError(t, webhookReplay(context.Background(), logger, WebhookReplayCmd{
+		From:   logPath,
+		To:     receiveServer.URL,
+		Secret: testSecret,
+	}))
+	require.Equal(t, []webhook.EventType{webhook.EventMeetingStarted}, replayed)
+}
+
+func TestWebhookListenForwardsRawBody(t *testing.T) {
+	// The Event type doesn't know about this field, so
+	// it only survives if the delivery is forwarded as is
+	const body = `{
+		"version": "1.0.0",
+		"type": "meeting.started",
+		"id": "mtg-start-forwarded",
+		"payload": {"room": "test", "meeting_id": "mtg-1", "start_ts": 1708972279.96},
+		"event_ts": 1708972279.961,
+		"unknown_field": {"nested": [1, 2, 3]}
+	}`
+
+	forwarded := make(chan []byte, 1)
+	receiver, err := webhook.NewHandler(testSecret, nil)
+	require.NoError(t, err)
+	receiver.OnEvent(func(ctx context.Context, e webhook.Event) error {
+		raw, ok := webhook.RawBody(ctx)
+		require.True(t, ok)
+		forwarded <- raw
+		return nil
+	})
+	receiveServer := httptest.NewServer(receiver)
+	defer receiveServer.Close()
+
+	// Find a free port for the listener
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- webhookListen(ctx, zap.NewNop().Sugar(), WebhookListenCmd{
+			Port:    port,
+			Secret:  testSecret,
+			Forward: receiveServer.URL,
+		})
+	}()
+
+	listenURL := fmt.Sprintf("http://127.0.0.1:%d", port)
+	require.Eventually(t, func() bool {
+		return webhook.Send(context.Background(), listenURL, testSecret, []byte(body)) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	select {
+	case got := <-forwarded:
+		require.JSONEq(t, body, string(got))
+	case <-time.After(5 * time.Second):
+		t.Fatal("event was not forwarded")
+	}
+
+	cancel()
+	require.NoError(t, <-listenErr)
+}
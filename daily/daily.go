@@ -8,6 +8,9 @@ import (
 
 const (
 	dailyURL = "https://api.daily.co/v1/"
+	// defaultRoomFanoutConcurrency is the default maximum number of
+	// rooms acted on at once by operations spanning multiple rooms.
+	defaultRoomFanoutConcurrency = 10
 )
 
 var (
@@ -24,6 +27,9 @@ type Daily struct {
 	apiKey         string
 	apiURL         string
 	defaultRoomExp time.Duration
+	// roomFanoutConcurrency is the maximum number of rooms acted
+	// on at once by operations spanning multiple rooms.
+	roomFanoutConcurrency int
 }
 
 // NewDaily returns a new instance of Daily
@@ -38,11 +44,25 @@ func NewDaily(apiKey string) (*Daily, error) {
 		apiKey: apiKey,
 		// This is set on the struct instead of just reusing the
 		// const to enable overriding for unit tests.
-		apiURL:         dailyURL,
-		defaultRoomExp: time.Hour * 24,
+		apiURL:                dailyURL,
+		defaultRoomExp:        time.Hour * 24,
+		roomFanoutConcurrency: defaultRoomFanoutConcurrency,
 	}, nil
 }
 
 func (d *Daily) WithDefaultRoomExpiry(duration time.Duration) {
 	d.defaultRoomExp = duration
 }
+
+// WithAPIURL sets the base URL of the Daily REST API,
+// e.g. to point at a test server.
+func (d *Daily) WithAPIURL(apiURL string) {
+	d.apiURL = apiURL
+}
+
+// WithRoomFanoutConcurrency sets the maximum number of rooms acted on
+// at once by operations spanning multiple rooms, i.e. broadcasting app
+// messages and ejecting a user from all rooms.
+func (d *Daily) WithRoomFanoutConcurrency(n int) {
+	d.roomFanoutConcurrency = n
+}
@@ -0,0 +1,26 @@
+package daily
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/room"
+)
+
+// StartDialOut calls the given phone number or SIP URI from the
+// meeting in the given Daily room and returns the session ID of
+// the dialed participant
+func (d *Daily) StartDialOut(ctx context.Context, roomName string, opts room.StartDialOutOpts) (string, error) {
+	return room.StartDialOut(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, opts)
+}
+
+// StopDialOut hangs up the dialed participant with the
+// given session ID in the given Daily room
+func (d *Daily) StopDialOut(ctx context.Context, roomName string, sessionID string) error {
+	return room.StopDialOut(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, sessionID)
+}
@@ -0,0 +1,25 @@
+package daily
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/domain"
+)
+
+// GetDomainConfig returns the Daily domain and
+// its domain-wide configuration
+func (d *Daily) GetDomainConfig(ctx context.Context) (*domain.Domain, error) {
+	return domain.Get(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	})
+}
+
+// UpdateDomainConfig changes the given domain-wide configuration
+// properties and returns the updated Daily domain
+func (d *Daily) UpdateDomainConfig(ctx context.Context, params domain.UpdateParams) (*domain.Domain, error) {
+	return domain.Update(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, params)
+}
@@ -0,0 +1,135 @@
+// Package domain handles domain-wide Daily configuration
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"net/url"
+)
+
+// Domain represents a Daily domain and its configuration
+type Domain struct {
+	Name            string `json:"domain_name"`
+	ID              string `json:"domain_id"`
+	Config          Props  `json:"config"`
+	AdditionalProps map[string]interface{}
+}
+
+// RecordingsBucket configures a custom S3 bucket
+// recordings are stored in
+type RecordingsBucket struct {
+	BucketName               string `json:"bucket_name"`
+	BucketRegion             string `json:"bucket_region"`
+	AssumeRoleARN            string `json:"assume_role_arn"`
+	AllowAPIAccess           bool   `json:"allow_api_access"`
+	AllowStreamingFromBucket bool   `json:"allow_streaming_from_bucket"`
+}
+
+// Props represents common domain configuration properties.
+// This does not represent _all_ properties supported by
+// Daily domains.
+// Properties are pointers so that updates only change the
+// properties which are set; nil properties are left as they are.
+type Props struct {
+	EnableAdvancedChat        *bool             `json:"enable_advanced_chat,omitempty"`
+	EnableChat                *bool             `json:"enable_chat,omitempty"`
+	EnableEmojiReactions      *bool             `json:"enable_emoji_reactions,omitempty"`
+	EnableHandRaising         *bool             `json:"enable_hand_raising,omitempty"`
+	EnableKnocking            *bool             `json:"enable_knocking,omitempty"`
+	EnableNetworkUI           *bool             `json:"enable_network_ui,omitempty"`
+	EnablePeopleUI            *bool             `json:"enable_people_ui,omitempty"`
+	EnablePipUI               *bool             `json:"enable_pip_ui,omitempty"`
+	EnablePrejoinUI           *bool             `json:"enable_prejoin_ui,omitempty"`
+	EnableScreenshare         *bool             `json:"enable_screenshare,omitempty"`
+	EnableTerseLogging        *bool             `json:"enable_terse_logging,omitempty"`
+	EnableRecording           *string           `json:"enable_recording,omitempty"`
+	HideDailyBranding         *bool             `json:"hide_daily_branding,omitempty"`
+	RedirectOnMeetingExit     *string           `json:"redirect_on_meeting_exit,omitempty"`
+	IntercomButton            *bool             `json:"intercom_button,omitempty"`
+	IntercomAutoRecord        *bool             `json:"intercom_auto_record,omitempty"`
+	Lang                      *string           `json:"lang,omitempty"`
+	MeetingJoinHook           *string           `json:"meeting_join_hook,omitempty"`
+	MaxLiveStreams            *int              `json:"max_live_streams,omitempty"`
+	RecordingsBucket          *RecordingsBucket `json:"recordings_bucket,omitempty"`
+	RecordingsTemplate        *string           `json:"recordings_template,omitempty"`
+	HIPAA                     *bool             `json:"hipaa,omitempty"`
+	SFUSwitchover             *float64          `json:"sfu_switchover,omitempty"`
+	AttachCallObjectToWindow  *bool             `json:"attach_callobject_to_window,omitempty"`
+	EnableAdaptiveSimulcast   *bool             `json:"enable_adaptive_simulcast,omitempty"`
+	EnableVideoProcessingUI   *bool             `json:"enable_video_processing_ui,omitempty"`
+	EnableNoiseCancellationUI *bool             `json:"enable_noise_cancellation_ui,omitempty"`
+}
+
+func GetPropsKeys() []string {
+	return []string{
+		"enable_advanced_chat", "enable_chat", "enable_emoji_reactions", "enable_hand_raising",
+		"enable_knocking", "enable_network_ui", "enable_people_ui", "enable_pip_ui",
+		"enable_prejoin_ui", "enable_screenshare", "enable_terse_logging", "enable_recording",
+		"hide_daily_branding", "redirect_on_meeting_exit", "intercom_button", "intercom_auto_record",
+		"lang", "meeting_join_hook", "max_live_streams", "recordings_bucket", "recordings_template",
+		"hipaa", "sfu_switchover", "attach_callobject_to_window", "enable_adaptive_simulcast",
+		"enable_video_processing_ui", "enable_noise_cancellation_ui",
+	}
+}
+
+func (d *Domain) UnmarshalJSON(data []byte) error {
+	dm := struct {
+		Name   string `json:"domain_name"`
+		ID     string `json:"domain_id"`
+		Config Props  `json:"config"`
+	}{}
+
+	if err := json.Unmarshal(data, &dm); err != nil {
+		return err
+	}
+
+	d.Name = dm.Name
+	d.ID = dm.ID
+	d.Config = dm.Config
+
+	// Check config values that are not in Props
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("failed to unmarshal body to map: %w", err)
+	}
+
+	if config, ok := m["config"].(map[string]interface{}); ok {
+		propsKeys := GetPropsKeys()
+		// Iterate over all config values and, if the keys are not
+		// in Props keys retrieved above, add these config keys
+		// and values into AdditionalProps
+		for k, v := range config {
+			if !isInSlice(k, propsKeys) {
+				if d.AdditionalProps == nil {
+					d.AdditionalProps = make(map[string]interface{})
+				}
+				d.AdditionalProps[k] = v
+			}
+		}
+	}
+
+	return nil
+}
+
+func isInSlice(ele string, s []string) bool {
+	for _, propsKey := range s {
+		if propsKey == ele {
+			return true
+		}
+	}
+	return false
+}
+
+// domainEndpoint returns the API root, which
+// is where domain configuration lives
+func domainEndpoint(apiURL string) (string, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return "", errors.NewErrFailedEndpointConstruction(err)
+	}
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	return u.String(), nil
+}
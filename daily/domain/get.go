@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+)
+
+// Get returns the domain and its configuration
+func Get(ctx context.Context, creds auth.Creds) (*Domain, error) {
+	endpoint, err := domainEndpoint(creds.APIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request to domain endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+
+	var domain Domain
+	if err := json.Unmarshal(resBody, &domain); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &domain, nil
+}
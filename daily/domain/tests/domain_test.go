@@ -0,0 +1,174 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/domain"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const domainBody = `
+	{
+		"domain_name": "somedomain",
+		"domain_id": "16fe7ad0-5d4a-4bcb-83ee-5e9d1a6e3e9b",
+		"config": {
+			"hide_daily_branding": false,
+			"redirect_on_meeting_exit": "https://example.com/bye",
+			"hipaa": false,
+			"intercom_button": true,
+			"lang": "de",
+			"enable_terse_logging": true,
+			"recordings_bucket": {
+				"bucket_name": "recordings",
+				"bucket_region": "us-west-2",
+				"assume_role_arn": "arn:aws:iam::123:role/daily",
+				"allow_api_access": true
+			},
+			"some_new_property": 42
+		}
+	}
+`
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name               string
+		dailyResStatusCode int
+		dailyResBody       string
+		wantErr            error
+	}{
+		{
+			name:               "bad status code",
+			dailyResStatusCode: http.StatusUnauthorized,
+			dailyResBody:       "{}",
+			wantErr:            errors.ErrFailedAPICall,
+		},
+		{
+			name:               "bad body",
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody:       `{"config": {"lang": 1}}`,
+			wantErr:            domain.ErrFailUnmarshal,
+		},
+		{
+			name:               "domain retrieved",
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody:       domainBody,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "GET", r.Method)
+				require.Equal(t, "/", r.URL.Path)
+				w.WriteHeader(tc.dailyResStatusCode)
+				_, err := w.Write([]byte(tc.dailyResBody))
+				require.NoError(t, err)
+			}))
+
+			defer testServer.Close()
+
+			gotDomain, gotErr := domain.Get(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			})
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			if gotErr != nil {
+				return
+			}
+			require.Equal(t, "somedomain", gotDomain.Name)
+			require.Equal(t, "16fe7ad0-5d4a-4bcb-83ee-5e9d1a6e3e9b", gotDomain.ID)
+			cfg := gotDomain.Config
+			require.False(t, *cfg.HideDailyBranding)
+			require.Equal(t, "https://example.com/bye", *cfg.RedirectOnMeetingExit)
+			require.True(t, *cfg.IntercomButton)
+			require.Equal(t, "de", *cfg.Lang)
+			require.True(t, *cfg.EnableTerseLogging)
+			require.Nil(t, cfg.EnableAdvancedChat)
+			require.Equal(t, &domain.RecordingsBucket{
+				BucketName:     "recordings",
+				BucketRegion:   "us-west-2",
+				AssumeRoleARN:  "arn:aws:iam::123:role/daily",
+				AllowAPIAccess: true,
+			}, cfg.RecordingsBucket)
+			require.Equal(t, map[string]interface{}{"some_new_property": float64(42)}, gotDomain.AdditionalProps)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+	disabled := false
+	lang := "fr"
+
+	testCases := []struct {
+		name               string
+		params             domain.UpdateParams
+		wantReqBody        string
+		dailyResStatusCode int
+		dailyResBody       string
+		wantErr            error
+	}{
+		{
+			name:               "bad status code",
+			wantReqBody:        `{"properties":{}}`,
+			dailyResStatusCode: http.StatusBadRequest,
+			dailyResBody:       "{}",
+			wantErr:            errors.ErrFailedAPICall,
+		},
+		{
+			name: "only set props are sent",
+			params: domain.UpdateParams{
+				Props: domain.Props{
+					HideDailyBranding: &disabled,
+					Lang:              &lang,
+				},
+				AdditionalProps: map[string]interface{}{
+					// Typed props take precedence
+					"lang":              "es",
+					"some_new_property": 42,
+				},
+			},
+			wantReqBody:        `{"properties":{"hide_daily_branding":false,"lang":"fr","some_new_property":42}}`,
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody:       domainBody,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "POST", r.Method)
+				require.Equal(t, "/", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantReqBody, string(body))
+				w.WriteHeader(tc.dailyResStatusCode)
+				_, err = w.Write([]byte(tc.dailyResBody))
+				require.NoError(t, err)
+			}))
+
+			defer testServer.Close()
+
+			gotDomain, gotErr := domain.Update(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, tc.params)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			if gotErr == nil {
+				require.Equal(t, "somedomain", gotDomain.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+)
+
+type UpdateParams struct {
+	Props Props
+	// AdditionalProps holds properties not covered by Props.
+	// Keys which are set in Props take precedence.
+	AdditionalProps map[string]interface{}
+}
+
+type updateDomainBody struct {
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// Update changes the given domain configuration properties,
+// leaving all others as they are, and returns the updated domain
+func Update(ctx context.Context, creds auth.Creds, params UpdateParams) (*Domain, error) {
+	endpoint, err := domainEndpoint(creds.APIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody, err := makeUpdateDomainBody(params.Props, params.AdditionalProps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make domain update request body: %w", err)
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create POST request to domain endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update domain: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+
+	var domain Domain
+	if err := json.Unmarshal(resBody, &domain); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &domain, nil
+}
+
+func makeUpdateDomainBody(props Props, additionalProps map[string]interface{}) (*bytes.Buffer, error) {
+	// Concatenate original and additional properties into a JSON blob
+	propsData, err := concatProperties(props, additionalProps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build domain props JSON: %w", err)
+	}
+
+	bodyBlob, err := json.Marshal(updateDomainBody{Properties: propsData})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	return bytes.NewBuffer(bodyBlob), nil
+}
+
+func concatProperties(props Props, additionalProps map[string]interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(&props)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal domain props: %w", err)
+	}
+
+	// Unmarshal all the original props into a map for us to work with
+	var mProps map[string]interface{}
+	if err := json.Unmarshal(data, &mProps); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal props: %w", err)
+	}
+
+	// Add additional props to prop map, but only if given key
+	// does not already exist in original props.
+	for k, v := range additionalProps {
+		if _, ok := mProps[k]; ok {
+			// This key already exists, skip it
+			continue
+		}
+		mProps[k] = v
+	}
+
+	return mProps, nil
+}
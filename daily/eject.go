@@ -0,0 +1,60 @@
+package daily
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/room"
+	"sort"
+)
+
+// EjectResult is the outcome of ejecting
+// participants from a single room
+type EjectResult struct {
+	RoomName   string
+	EjectedIDs []string
+	Err        error
+}
+
+// EjectParticipants removes the given participants from the given
+// Daily room and returns the session IDs of the ejected participants
+func (d *Daily) EjectParticipants(ctx context.Context, roomName string, params room.EjectParams) ([]string, error) {
+	return room.Eject(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, params)
+}
+
+// EjectUserFromAllRooms ejects the participant with the given user ID from
+// every Daily room they are currently present in. A failure to eject from
+// one room does not prevent ejecting from the others; per-room outcomes
+// are returned ordered by room name. Rooms are ejected from concurrently,
+// up to the configured room fan-out concurrency.
+func (d *Daily) EjectUserFromAllRooms(ctx context.Context, userID string) ([]EjectResult, error) {
+	p, err := d.GetPresence(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find rooms user is present in: %w", err)
+	}
+
+	var roomNames []string
+	for roomName, participants := range p {
+		for _, participant := range participants {
+			if participant.UserID == userID {
+				roomNames = append(roomNames, roomName)
+				break
+			}
+		}
+	}
+	sort.Strings(roomNames)
+
+	return forEachRoom(d, roomNames, func(roomName string) EjectResult {
+		ejected, err := d.EjectParticipants(ctx, roomName, room.EjectParams{
+			UserIDs: []string{userID},
+		})
+		return EjectResult{
+			RoomName:   roomName,
+			EjectedIDs: ejected,
+			Err:        err,
+		}
+	}), nil
+}
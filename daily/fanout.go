@@ -0,0 +1,25 @@
+package daily
+
+import "golang.org/x/sync/errgroup"
+
+// forEachRoom calls fn for each of the given rooms, concurrently up to
+// the configured room fan-out concurrency, and returns the results in
+// the same order as the rooms. fn should record any error in its result,
+// so a failure in one room does not affect the others.
+func forEachRoom[T any](d *Daily, roomNames []string, fn func(roomName string) T) []T {
+	results := make([]T, len(roomNames))
+	var g errgroup.Group
+	if d.roomFanoutConcurrency > 0 {
+		g.SetLimit(d.roomFanoutConcurrency)
+	}
+	for i, roomName := range roomNames {
+		i, roomName := i, roomName
+		g.Go(func() error {
+			results[i] = fn(roomName)
+			return nil
+		})
+	}
+	// fn cannot fail the group, so there is no error to check
+	_ = g.Wait()
+	return results
+}
@@ -0,0 +1,31 @@
+package daily
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/room"
+)
+
+// StartLiveStreaming starts streaming the given Daily room to RTMP endpoints
+func (d *Daily) StartLiveStreaming(ctx context.Context, roomName string, opts room.StartLiveStreamingOpts) error {
+	return room.StartLiveStreaming(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, opts)
+}
+
+// UpdateLiveStreaming updates an ongoing live stream of the given Daily room
+func (d *Daily) UpdateLiveStreaming(ctx context.Context, roomName string, opts room.UpdateLiveStreamingOpts) error {
+	return room.UpdateLiveStreaming(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, opts)
+}
+
+// StopLiveStreaming stops a live stream of the given Daily room
+func (d *Daily) StopLiveStreaming(ctx context.Context, roomName string, opts *room.StopLiveStreamingOpts) error {
+	return room.StopLiveStreaming(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, opts)
+}
@@ -0,0 +1,25 @@
+package daily
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/logs"
+)
+
+// GetLogs returns call logs and quality
+// metrics matching the given params
+func (d *Daily) GetLogs(ctx context.Context, params logs.GetParams) (*logs.Result, error) {
+	return logs.Get(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, params)
+}
+
+// GetAllCallMetrics returns all call quality metrics matching
+// the given params, retrieving as many pages as needed
+func (d *Daily) GetAllCallMetrics(ctx context.Context, params logs.GetParams) ([]logs.Metric, error) {
+	return logs.GetAllMetrics(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, params)
+}
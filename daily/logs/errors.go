@@ -0,0 +1,19 @@
+package logs
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrFailUnmarshal = errors.New("failed to unmarshal response body into logs")
+	ErrInvalidParams = errors.New("invalid log params")
+)
+
+func NewErrFailUnmarshal(unmarshalErr error) error {
+	return fmt.Errorf("%s: %w", unmarshalErr, ErrFailUnmarshal)
+}
+
+func NewErrInvalidParams(err error) error {
+	return fmt.Errorf("%s: %w", err, ErrInvalidParams)
+}
@@ -0,0 +1,173 @@
+package logs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// MaxLimit is the largest number of logs and
+// metrics Daily returns from a single request
+const MaxLimit = 1000
+
+// LogLevel filters logs by severity
+type LogLevel string
+
+const (
+	LogLevelError LogLevel = "ERROR"
+	LogLevelInfo  LogLevel = "INFO"
+	LogLevelDebug LogLevel = "DEBUG"
+)
+
+type GetParams struct {
+	IncludeLogs    bool
+	IncludeMetrics bool
+	// MtgSessionID only retrieves logs and metrics
+	// of the given meeting session
+	MtgSessionID string
+	// UserSessionID only retrieves logs and metrics
+	// of the given participant session
+	UserSessionID string
+	LogLevel      LogLevel
+	// StartTime only retrieves logs and metrics
+	// reported at or after this time
+	StartTime time.Time
+	// EndTime only retrieves logs and metrics
+	// reported before this time
+	EndTime time.Time
+	// Limit is the maximum number of logs and of
+	// metrics to retrieve, up to MaxLimit. If 0,
+	// Daily's default is used.
+	Limit  int
+	Offset int
+}
+
+// Get returns the logs and metrics matching the given params
+func Get(ctx context.Context, creds auth.Creds, params GetParams) (*Result, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := logsEndpointWithParams(creds.APIURL, params.query())
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request to logs endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+
+	var result Result
+	if err := json.Unmarshal(resBody, &result); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &result, nil
+}
+
+func (p GetParams) validate() error {
+	if !p.IncludeLogs && !p.IncludeMetrics {
+		return NewErrInvalidParams(fmt.Errorf("at least one of logs or metrics must be included"))
+	}
+	if p.Limit < 0 || p.Limit > MaxLimit {
+		return NewErrInvalidParams(fmt.Errorf("limit must be between 0 and %d: %d", MaxLimit, p.Limit))
+	}
+	if p.Offset < 0 {
+		return NewErrInvalidParams(fmt.Errorf("offset cannot be negative: %d", p.Offset))
+	}
+	if !p.StartTime.IsZero() && !p.EndTime.IsZero() && !p.EndTime.After(p.StartTime) {
+		return NewErrInvalidParams(fmt.Errorf("end time must be after start time"))
+	}
+	return nil
+}
+
+func (p GetParams) query() url.Values {
+	q := url.Values{}
+	// Daily includes logs unless told otherwise,
+	// so always be explicit about both
+	q.Set("includeLogs", strconv.FormatBool(p.IncludeLogs))
+	q.Set("includeMetrics", strconv.FormatBool(p.IncludeMetrics))
+	if p.MtgSessionID != "" {
+		q.Set("mtgSessionId", p.MtgSessionID)
+	}
+	if p.UserSessionID != "" {
+		q.Set("userSessionId", p.UserSessionID)
+	}
+	if p.LogLevel != "" {
+		q.Set("logLevel", string(p.LogLevel))
+	}
+	// Daily takes times in milliseconds since the epoch
+	if !p.StartTime.IsZero() {
+		q.Set("startTime", strconv.FormatInt(p.StartTime.UnixMilli(), 10))
+	}
+	if !p.EndTime.IsZero() {
+		q.Set("endTime", strconv.FormatInt(p.EndTime.UnixMilli(), 10))
+	}
+	if p.Limit > 0 {
+		q.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Offset > 0 {
+		q.Set("offset", strconv.Itoa(p.Offset))
+	}
+	return q
+}
+
+// GetAllMetrics returns all metrics matching the given params, paging
+// through them MaxLimit at a time from params.Offset until Daily returns
+// a short page. Logs are not retrieved. params.Limit caps the total
+// number of metrics returned; if 0, all matching metrics are returned.
+func GetAllMetrics(ctx context.Context, creds auth.Creds, params GetParams) ([]Metric, error) {
+	total := params.Limit
+	if total < 0 {
+		return nil, NewErrInvalidParams(fmt.Errorf("limit cannot be negative: %d", total))
+	}
+	params.IncludeLogs = false
+	params.IncludeMetrics = true
+
+	var metrics []Metric
+	for {
+		params.Limit = MaxLimit
+		if total > 0 && total-len(metrics) < MaxLimit {
+			params.Limit = total - len(metrics)
+		}
+		res, err := Get(ctx, creds, params)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, res.Metrics...)
+
+		// A short page means there is nothing more to retrieve
+		if len(res.Metrics) < params.Limit || (total > 0 && len(metrics) >= total) {
+			return metrics, nil
+		}
+		params.Offset += len(res.Metrics)
+	}
+}
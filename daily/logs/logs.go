@@ -0,0 +1,68 @@
+// Package logs handles Daily's call logs and quality metrics
+package logs
+
+import (
+	"github.com/lazeratops/daily-go/daily/errors"
+	"net/url"
+	"path"
+	"time"
+)
+
+// Log is a single log line reported by a participant's client
+type Log struct {
+	Time          time.Time `json:"time"`
+	ClientTime    time.Time `json:"clientTime"`
+	Message       string    `json:"message"`
+	MtgSessionID  string    `json:"mtgSessionId"`
+	UserSessionID string    `json:"userSessionId"`
+	PeerID        string    `json:"peerId"`
+	DomainName    string    `json:"domainName"`
+	Level         int       `json:"level"`
+	Code          string    `json:"code"`
+}
+
+// Metric is a single sample of a participant's
+// network stats during a meeting
+type Metric struct {
+	Time          time.Time `json:"time"`
+	ClientTime    time.Time `json:"clientTime"`
+	MtgSessionID  string    `json:"mtgSessionId"`
+	UserSessionID string    `json:"userSessionId"`
+	RoomName      string    `json:"roomName"`
+	DomainName    string    `json:"domainName"`
+	Stats         Stats     `json:"metrics"`
+}
+
+// Stats holds the network stats of a metric sample. Stats
+// which were not reported in the sample are nil.
+type Stats struct {
+	VideoRecvBitsPerSecond *float64 `json:"videoRecvBitsPerSecond"`
+	VideoSendBitsPerSecond *float64 `json:"videoSendBitsPerSecond"`
+	AudioRecvBitsPerSecond *float64 `json:"audioRecvBitsPerSecond"`
+	AudioSendBitsPerSecond *float64 `json:"audioSendBitsPerSecond"`
+	// Packet loss is reported as a fraction between 0 and 1
+	VideoRecvPacketLoss *float64 `json:"videoRecvPacketLoss"`
+	VideoSendPacketLoss *float64 `json:"videoSendPacketLoss"`
+	TotalRecvPacketLoss *float64 `json:"totalRecvPacketLoss"`
+	TotalSendPacketLoss *float64 `json:"totalSendPacketLoss"`
+	// RoundTripTime is reported in seconds
+	RoundTripTime            *float64 `json:"roundTripTime"`
+	AvailableOutgoingBitrate *float64 `json:"availableOutgoingBitrate"`
+}
+
+// Result holds the logs and metrics retrieved from Daily
+type Result struct {
+	Logs    []Log    `json:"logs"`
+	Metrics []Metric `json:"metrics"`
+}
+
+func logsEndpointWithParams(apiURL string, query url.Values) (string, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return "", errors.NewErrFailedEndpointConstruction(err)
+	}
+
+	u.Path = path.Join(u.Path, "logs")
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
@@ -0,0 +1,229 @@
+package logs
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	defaultMaxPacketLoss    = 0.05
+	defaultMaxRoundTripTime = 400 * time.Millisecond
+	defaultMinBitrate       = 150_000
+)
+
+// Issue is a call quality problem flagged by Summarize
+type Issue string
+
+const (
+	IssueHighPacketLoss Issue = "high-packet-loss"
+	IssueHighRTT        Issue = "high-rtt"
+	IssueLowBitrate     Issue = "low-bitrate"
+)
+
+// SummaryOpts represents optional thresholds for flagging call
+// quality issues. Unset thresholds use their defaults, while
+// thresholds explicitly set to 0 are applied as is.
+type SummaryOpts struct {
+	// MaxPacketLoss is the highest acceptable average packet
+	// loss, as a fraction between 0 and 1. Defaults to 0.05.
+	MaxPacketLoss *float64
+	// MaxRoundTripTime is the highest acceptable average
+	// round trip time. Defaults to 400ms.
+	MaxRoundTripTime *time.Duration
+	// MinBitrate is the lowest acceptable average send bitrate
+	// in bits per second, across samples in which the participant
+	// is sending video. Audio-only participants are never flagged.
+	// Defaults to 150kbps.
+	MinBitrate *float64
+}
+
+// thresholds are the resolved thresholds of SummaryOpts
+type thresholds struct {
+	maxPacketLoss    float64
+	maxRoundTripTime time.Duration
+	minBitrate       float64
+}
+
+// ParticipantSummary summarizes the call quality
+// of a single participant session
+type ParticipantSummary struct {
+	UserSessionID string
+	MtgSessionID  string
+	Samples       int
+	// Packet loss is the worse of send and receive
+	// packet loss in each sample
+	AvgPacketLoss    float64
+	MaxPacketLoss    float64
+	AvgRoundTripTime time.Duration
+	MaxRoundTripTime time.Duration
+	AvgSendBitrate   float64
+	AvgRecvBitrate   float64
+	Issues           []Issue
+}
+
+// HasIssues reports whether any call quality
+// issues were flagged for the participant
+func (s *ParticipantSummary) HasIssues() bool {
+	return len(s.Issues) > 0
+}
+
+// Summarize aggregates the given metrics per participant session and
+// flags participants whose average packet loss, round trip time or send
+// bitrate is outside the given thresholds. Averages only take samples
+// which reported the relevant stat into account. Summaries are ordered
+// by meeting session and then participant session.
+func Summarize(metrics []Metric, opts *SummaryOpts) []ParticipantSummary {
+	o := thresholds{
+		maxPacketLoss:    defaultMaxPacketLoss,
+		maxRoundTripTime: defaultMaxRoundTripTime,
+		minBitrate:       defaultMinBitrate,
+	}
+	if opts != nil {
+		if opts.MaxPacketLoss != nil {
+			o.maxPacketLoss = *opts.MaxPacketLoss
+		}
+		if opts.MaxRoundTripTime != nil {
+			o.maxRoundTripTime = *opts.MaxRoundTripTime
+		}
+		if opts.MinBitrate != nil {
+			o.minBitrate = *opts.MinBitrate
+		}
+	}
+
+	type key struct {
+		mtgSessionID  string
+		userSessionID string
+	}
+	accs := make(map[key]*accumulator)
+	for _, m := range metrics {
+		k := key{mtgSessionID: m.MtgSessionID, userSessionID: m.UserSessionID}
+		acc, ok := accs[k]
+		if !ok {
+			acc = &accumulator{}
+			accs[k] = acc
+		}
+		acc.add(m.Stats)
+	}
+
+	summaries := make([]ParticipantSummary, 0, len(accs))
+	for k, acc := range accs {
+		s := acc.summary(o)
+		s.MtgSessionID = k.mtgSessionID
+		s.UserSessionID = k.userSessionID
+		summaries = append(summaries, s)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].MtgSessionID != summaries[j].MtgSessionID {
+			return summaries[i].MtgSessionID < summaries[j].MtgSessionID
+		}
+		return summaries[i].UserSessionID < summaries[j].UserSessionID
+	})
+	return summaries
+}
+
+// accumulator collects the stats of a single participant session
+type accumulator struct {
+	samples  int
+	loss     stat
+	rtt      stat
+	sendRate stat
+	recvRate stat
+	// videoSendRate is the total send rate of
+	// samples in which video is being sent
+	videoSendRate stat
+}
+
+// stat is a running sum, count and maximum of a single stat
+type stat struct {
+	sum   float64
+	count int
+	max   float64
+}
+
+func (s *stat) add(v float64) {
+	s.sum += v
+	s.count++
+	if s.count == 1 || v > s.max {
+		s.max = v
+	}
+}
+
+func (s *stat) avg() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	return s.sum / float64(s.count)
+}
+
+func (a *accumulator) add(st Stats) {
+	a.samples++
+	if loss, ok := maxOf(st.TotalSendPacketLoss, st.TotalRecvPacketLoss, st.VideoSendPacketLoss, st.VideoRecvPacketLoss); ok {
+		a.loss.add(loss)
+	}
+	if st.RoundTripTime != nil {
+		a.rtt.add(*st.RoundTripTime)
+	}
+	if rate, ok := sumOf(st.VideoSendBitsPerSecond, st.AudioSendBitsPerSecond); ok {
+		a.sendRate.add(rate)
+		if st.VideoSendBitsPerSecond != nil {
+			a.videoSendRate.add(rate)
+		}
+	}
+	if rate, ok := sumOf(st.VideoRecvBitsPerSecond, st.AudioRecvBitsPerSecond); ok {
+		a.recvRate.add(rate)
+	}
+}
+
+func (a *accumulator) summary(o thresholds) ParticipantSummary {
+	s := ParticipantSummary{
+		Samples:          a.samples,
+		AvgPacketLoss:    a.loss.avg(),
+		MaxPacketLoss:    a.loss.max,
+		AvgRoundTripTime: secondsToDuration(a.rtt.avg()),
+		MaxRoundTripTime: secondsToDuration(a.rtt.max),
+		AvgSendBitrate:   a.sendRate.avg(),
+		AvgRecvBitrate:   a.recvRate.avg(),
+	}
+	if a.loss.count > 0 && s.AvgPacketLoss > o.maxPacketLoss {
+		s.Issues = append(s.Issues, IssueHighPacketLoss)
+	}
+	if a.rtt.count > 0 && s.AvgRoundTripTime > o.maxRoundTripTime {
+		s.Issues = append(s.Issues, IssueHighRTT)
+	}
+	if a.videoSendRate.count > 0 && a.videoSendRate.avg() < o.minBitrate {
+		s.Issues = append(s.Issues, IssueLowBitrate)
+	}
+	return s
+}
+
+// maxOf returns the largest of the given values which are
+// set, and reports whether any of them were set
+func maxOf(values ...*float64) (float64, bool) {
+	var max float64
+	var found bool
+	for _, v := range values {
+		if v != nil && (!found || *v > max) {
+			max = *v
+			found = true
+		}
+	}
+	return max, found
+}
+
+// sumOf returns the sum of the given values which are
+// set, and reports whether any of them were set
+func sumOf(values ...*float64) (float64, bool) {
+	var sum float64
+	var found bool
+	for _, v := range values {
+		if v != nil {
+			sum += *v
+			found = true
+		}
+	}
+	return sum, found
+}
+
+func secondsToDuration(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
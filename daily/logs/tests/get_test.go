@@ -0,0 +1,271 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/logs"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const logsBody = `
+	{
+		"logs": [
+			{
+				"time": "2023-01-31T15:04:05.123Z",
+				"clientTime": "2023-01-31T15:04:05.100Z",
+				"message": "joined meeting",
+				"mtgSessionId": "mtg-1",
+				"userSessionId": "user-1",
+				"peerId": "user-1",
+				"domainName": "somedomain",
+				"level": 1
+			}
+		],
+		"metrics": [
+			{
+				"time": "2023-01-31T15:04:20Z",
+				"clientTime": "2023-01-31T15:04:20Z",
+				"mtgSessionId": "mtg-1",
+				"userSessionId": "user-1",
+				"roomName": "some-room",
+				"domainName": "somedomain",
+				"metrics": {
+					"videoSendBitsPerSecond": 500000,
+					"totalRecvPacketLoss": 0.02,
+					"roundTripTime": 0.12
+				}
+			}
+		]
+	}
+`
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+	loss := 0.02
+	rtt := 0.12
+	sendRate := 500000.0
+
+	testCases := []struct {
+		name               string
+		params             logs.GetParams
+		wantQuery          map[string]string
+		dailyResStatusCode int
+		dailyResBody       string
+		wantResult         *logs.Result
+		wantErr            error
+	}{
+		{
+			name:    "nothing included",
+			params:  logs.GetParams{MtgSessionID: "mtg-1"},
+			wantErr: logs.ErrInvalidParams,
+		},
+		{
+			name: "limit too high",
+			params: logs.GetParams{
+				IncludeLogs: true,
+				Limit:       logs.MaxLimit + 1,
+			},
+			wantErr: logs.ErrInvalidParams,
+		},
+		{
+			name: "end before start",
+			params: logs.GetParams{
+				IncludeLogs: true,
+				StartTime:   time.UnixMilli(1675177445000),
+				EndTime:     time.UnixMilli(1675177400000),
+			},
+			wantErr: logs.ErrInvalidParams,
+		},
+		{
+			name: "bad status code",
+			params: logs.GetParams{
+				IncludeLogs: true,
+			},
+			dailyResStatusCode: http.StatusBadRequest,
+			dailyResBody:       "{}",
+			wantErr:            errors.ErrFailedAPICall,
+		},
+		{
+			name: "logs and metrics retrieved",
+			params: logs.GetParams{
+				IncludeLogs:    true,
+				IncludeMetrics: true,
+				MtgSessionID:   "mtg-1",
+				UserSessionID:  "user-1",
+				LogLevel:       logs.LogLevelInfo,
+				StartTime:      time.UnixMilli(1675177445000),
+				EndTime:        time.UnixMilli(1675177500000),
+				Limit:          50,
+				Offset:         10,
+			},
+			wantQuery: map[string]string{
+				"includeLogs":    "true",
+				"includeMetrics": "true",
+				"mtgSessionId":   "mtg-1",
+				"userSessionId":  "user-1",
+				"logLevel":       "INFO",
+				"startTime":      "1675177445000",
+				"endTime":        "1675177500000",
+				"limit":          "50",
+				"offset":         "10",
+			},
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody:       logsBody,
+			wantResult: &logs.Result{
+				Logs: []logs.Log{
+					{
+						Time:          time.Date(2023, 1, 31, 15, 4, 5, 123e6, time.UTC),
+						ClientTime:    time.Date(2023, 1, 31, 15, 4, 5, 100e6, time.UTC),
+						Message:       "joined meeting",
+						MtgSessionID:  "mtg-1",
+						UserSessionID: "user-1",
+						PeerID:        "user-1",
+						DomainName:    "somedomain",
+						Level:         1,
+					},
+				},
+				Metrics: []logs.Metric{
+					{
+						Time:          time.Date(2023, 1, 31, 15, 4, 20, 0, time.UTC),
+						ClientTime:    time.Date(2023, 1, 31, 15, 4, 20, 0, time.UTC),
+						MtgSessionID:  "mtg-1",
+						UserSessionID: "user-1",
+						RoomName:      "some-room",
+						DomainName:    "somedomain",
+						Stats: logs.Stats{
+							VideoSendBitsPerSecond: &sendRate,
+							TotalRecvPacketLoss:    &loss,
+							RoundTripTime:          &rtt,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/logs", r.URL.Path)
+				q := r.URL.Query()
+				for k, v := range tc.wantQuery {
+					require.Equal(t, v, q.Get(k), k)
+				}
+				w.WriteHeader(tc.dailyResStatusCode)
+				_, err := w.Write([]byte(tc.dailyResBody))
+				require.NoError(t, err)
+			}))
+
+			defer testServer.Close()
+
+			gotResult, gotErr := logs.Get(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, tc.params)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			if gotErr == nil {
+				require.Equal(t, tc.wantResult, gotResult)
+			}
+		})
+	}
+}
+
+func TestGetAllMetrics(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		available        int
+		limit            int
+		wantOffsets      []string
+		wantLimits       []string
+		wantMetricsCount int
+	}{
+		{
+			name:             "single-short-page",
+			available:        10,
+			wantOffsets:      []string{""},
+			wantLimits:       []string{"1000"},
+			wantMetricsCount: 10,
+		},
+		{
+			name:             "multiple-pages",
+			available:        2500,
+			wantOffsets:      []string{"", "1000", "2000"},
+			wantLimits:       []string{"1000", "1000", "1000"},
+			wantMetricsCount: 2500,
+		},
+		{
+			name:             "exact-page-multiple",
+			available:        1000,
+			wantOffsets:      []string{"", "1000"},
+			wantLimits:       []string{"1000", "1000"},
+			wantMetricsCount: 1000,
+		},
+		{
+			name:             "capped-by-limit",
+			available:        2500,
+			limit:            1200,
+			wantOffsets:      []string{"", "1000"},
+			wantLimits:       []string{"1000", "200"},
+			wantMetricsCount: 1200,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotOffsets, gotLimits []string
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				require.Equal(t, "true", q.Get("includeMetrics"))
+				gotOffsets = append(gotOffsets, q.Get("offset"))
+				gotLimits = append(gotLimits, q.Get("limit"))
+
+				offset, _ := strconv.Atoi(q.Get("offset"))
+				limit, err := strconv.Atoi(q.Get("limit"))
+				require.NoError(t, err)
+				count := tc.available - offset
+				if count > limit {
+					count = limit
+				}
+				if count < 0 {
+					count = 0
+				}
+				body, err := json.Marshal(logs.Result{
+					Metrics: make([]logs.Metric, count),
+				})
+				require.NoError(t, err)
+				w.WriteHeader(http.StatusOK)
+				_, err = w.Write(body)
+				require.NoError(t, err)
+			}))
+
+			defer testServer.Close()
+
+			gotMetrics, gotErr := logs.GetAllMetrics(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, logs.GetParams{
+				MtgSessionID: "mtg-1",
+				Limit:        tc.limit,
+			})
+			require.NoError(t, gotErr)
+			require.Len(t, gotMetrics, tc.wantMetricsCount)
+			require.Equal(t, tc.wantOffsets, gotOffsets)
+			require.Equal(t, tc.wantLimits, gotLimits)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"github.com/lazeratops/daily-go/daily/logs"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func f(v float64) *float64 {
+	return &v
+}
+
+func dur(v time.Duration) *time.Duration {
+	return &v
+}
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+	metrics := []logs.Metric{
+		{
+			MtgSessionID:  "mtg-1",
+			UserSessionID: "bad-network",
+			Stats: logs.Stats{
+				TotalSendPacketLoss:    f(0.04),
+				TotalRecvPacketLoss:    f(0.10),
+				RoundTripTime:          f(0.5),
+				VideoSendBitsPerSecond: f(80_000),
+				AudioSendBitsPerSecond: f(20_000),
+			},
+		},
+		{
+			MtgSessionID:  "mtg-1",
+			UserSessionID: "good-network",
+			Stats: logs.Stats{
+				TotalSendPacketLoss:    f(0),
+				RoundTripTime:          f(0.05),
+				VideoSendBitsPerSecond: f(900_000),
+				VideoRecvBitsPerSecond: f(1_000_000),
+			},
+		},
+		{
+			MtgSessionID:  "mtg-1",
+			UserSessionID: "bad-network",
+			Stats: logs.Stats{
+				TotalRecvPacketLoss:    f(0.02),
+				RoundTripTime:          f(0.35),
+				VideoSendBitsPerSecond: f(100_000),
+			},
+		},
+		{
+			// Only reports bitrate, so nothing else can be flagged
+			MtgSessionID:  "mtg-1",
+			UserSessionID: "no-stats",
+			Stats: logs.Stats{
+				VideoSendBitsPerSecond: f(300_000),
+			},
+		},
+		{
+			// Audio-only participants send far less than the bitrate
+			// threshold, which is only meaningful for video
+			MtgSessionID:  "mtg-1",
+			UserSessionID: "voice-only",
+			Stats: logs.Stats{
+				RoundTripTime:          f(0.05),
+				AudioSendBitsPerSecond: f(40_000),
+				AudioRecvBitsPerSecond: f(40_000),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		opts       *logs.SummaryOpts
+		wantIssues map[string][]logs.Issue
+	}{
+		{
+			name: "default thresholds",
+			wantIssues: map[string][]logs.Issue{
+				"bad-network":  {logs.IssueHighPacketLoss, logs.IssueHighRTT, logs.IssueLowBitrate},
+				"good-network": nil,
+				"no-stats":     nil,
+				"voice-only":   nil,
+			},
+		},
+		{
+			name: "custom thresholds",
+			opts: &logs.SummaryOpts{
+				MaxPacketLoss:    f(0.1),
+				MaxRoundTripTime: dur(time.Second),
+				MinBitrate:       f(500_000),
+			},
+			wantIssues: map[string][]logs.Issue{
+				"bad-network":  {logs.IssueLowBitrate},
+				"good-network": nil,
+				"no-stats":     {logs.IssueLowBitrate},
+				"voice-only":   nil,
+			},
+		},
+		{
+			name: "zero thresholds",
+			opts: &logs.SummaryOpts{
+				MaxPacketLoss:    f(0),
+				MaxRoundTripTime: dur(0),
+				MinBitrate:       f(0),
+			},
+			wantIssues: map[string][]logs.Issue{
+				"bad-network":  {logs.IssueHighPacketLoss, logs.IssueHighRTT},
+				"good-network": {logs.IssueHighRTT},
+				"no-stats":     nil,
+				"voice-only":   {logs.IssueHighRTT},
+			},
+		},
+		{
+			name: "unset thresholds use defaults",
+			opts: &logs.SummaryOpts{
+				MinBitrate: f(0),
+			},
+			wantIssues: map[string][]logs.Issue{
+				"bad-network":  {logs.IssueHighPacketLoss, logs.IssueHighRTT},
+				"good-network": nil,
+				"no-stats":     nil,
+				"voice-only":   nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			summaries := logs.Summarize(metrics, tc.opts)
+			require.Len(t, summaries, 4)
+			gotIssues := make(map[string][]logs.Issue)
+			for _, s := range summaries {
+				gotIssues[s.UserSessionID] = s.Issues
+			}
+			require.Equal(t, tc.wantIssues, gotIssues)
+		})
+	}
+
+	summaries := logs.Summarize(metrics, nil)
+	bad := summaries[0]
+	require.Equal(t, "bad-network", bad.UserSessionID)
+	require.Equal(t, 2, bad.Samples)
+	require.InDelta(t, 0.06, bad.AvgPacketLoss, 1e-9)
+	require.InDelta(t, 0.10, bad.MaxPacketLoss, 1e-9)
+	require.Equal(t, 425*time.Millisecond, bad.AvgRoundTripTime)
+	require.Equal(t, 500*time.Millisecond, bad.MaxRoundTripTime)
+	require.InDelta(t, 100_000, bad.AvgSendBitrate, 1e-9)
+	require.True(t, bad.HasIssues())
+}
@@ -0,0 +1,33 @@
+package daily
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/meeting"
+)
+
+// GetMeetings returns past and ongoing Daily meetings
+// matching the given filters, if any
+func (d *Daily) GetMeetings(ctx context.Context, params *meeting.GetManyParams) ([]meeting.Meeting, error) {
+	return meeting.GetMany(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, params)
+}
+
+// GetMeeting returns a single Daily meeting matching the given ID
+func (d *Daily) GetMeeting(ctx context.Context, meetingID string) (*meeting.Meeting, error) {
+	return meeting.GetOne(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, meetingID)
+}
+
+// GetMeetingParticipants returns the participants
+// of the given Daily meeting
+func (d *Daily) GetMeetingParticipants(ctx context.Context, meetingID string, params *meeting.GetParticipantsParams) ([]meeting.Participant, error) {
+	return meeting.GetParticipants(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, meetingID, params)
+}
@@ -0,0 +1,14 @@
+package meeting
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrFailUnmarshal = errors.New("failed to unmarshal response body into Meeting")
+)
+
+func NewErrFailUnmarshal(unmarshalErr error) error {
+	return fmt.Errorf("%s: %w", unmarshalErr, ErrFailUnmarshal)
+}
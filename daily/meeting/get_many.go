@@ -0,0 +1,77 @@
+package meeting
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/pagination"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type GetManyParams struct {
+	// Limit is the maximum number of meetings to
+	// retrieve. If 0, all meetings are retrieved.
+	Limit         int
+	StartingAfter string
+	// Room only retrieves meetings of the given room
+	Room string
+	// TimeframeStart only retrieves meetings
+	// which started at or after this time
+	TimeframeStart time.Time
+	// TimeframeEnd only retrieves meetings
+	// which started before this time
+	TimeframeEnd time.Time
+	// Ongoing, if set, only retrieves meetings which
+	// are ongoing (true) or have ended (false)
+	Ongoing *bool
+}
+
+// GetMany returns meetings matching the given params, if any.
+// If no params are given, all meetings are returned.
+func GetMany(ctx context.Context, creds auth.Creds, params *GetManyParams) ([]Meeting, error) {
+	if params == nil {
+		params = &GetManyParams{}
+	}
+	if err := pagination.ValidateLimit(params.Limit); err != nil {
+		return nil, err
+	}
+	return pagination.Collect(params.Limit, params.StartingAfter, func(cursor string, limit int) (*pagination.Page[Meeting], error) {
+		return doGetMeetings(ctx, creds, params, cursor, limit)
+	}, func(m Meeting) string {
+		return m.ID
+	})
+}
+
+func doGetMeetings(ctx context.Context, creds auth.Creds, params *GetManyParams, cursor string, limit int) (*pagination.Page[Meeting], error) {
+	q := url.Values{}
+	pagination.SetQueryParams(q, cursor, limit)
+	if params.Room != "" {
+		q.Set("room", params.Room)
+	}
+	if !params.TimeframeStart.IsZero() {
+		q.Set("timeframe_start", strconv.FormatInt(params.TimeframeStart.Unix(), 10))
+	}
+	if !params.TimeframeEnd.IsZero() {
+		q.Set("timeframe_end", strconv.FormatInt(params.TimeframeEnd.Unix(), 10))
+	}
+	if params.Ongoing != nil {
+		q.Set("ongoing", strconv.FormatBool(*params.Ongoing))
+	}
+	endpoint, err := meetingsEndpointWithParams(creds.APIURL, q)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := doGet(ctx, creds, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var page pagination.Page[Meeting]
+	if err := json.Unmarshal(resBody, &page); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &page, nil
+}
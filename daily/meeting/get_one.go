@@ -0,0 +1,59 @@
+package meeting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+)
+
+// GetOne returns the meeting with the given ID
+func GetOne(ctx context.Context, creds auth.Creds, meetingID string) (*Meeting, error) {
+	endpoint, err := meetingsEndpoint(creds.APIURL, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := doGet(ctx, creds, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var meeting Meeting
+	if err := json.Unmarshal(resBody, &meeting); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &meeting, nil
+}
+
+func doGet(ctx context.Context, creds auth.Creds, endpoint string) ([]byte, error) {
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request to meetings endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get meetings: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+	return resBody, nil
+}
@@ -0,0 +1,120 @@
+// Package meeting handles the history of Daily meetings and their participants
+package meeting
+
+import (
+	"encoding/json"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"net/url"
+	"path"
+	"time"
+)
+
+// Meeting represents a single session of a Daily room,
+// from the first participant joining to the last one leaving
+type Meeting struct {
+	ID              string
+	Room            string
+	StartTime       time.Time
+	Duration        time.Duration
+	Ongoing         bool
+	MaxParticipants int
+	Participants    []Participant
+}
+
+// Participant represents a single participant's
+// time in a meeting
+type Participant struct {
+	// ParticipantID is the participant's session ID
+	ParticipantID string
+	UserID        string
+	UserName      string
+	JoinTime      time.Time
+	Duration      time.Duration
+}
+
+func (m *Meeting) UnmarshalJSON(data []byte) error {
+	// Daily reports times as Unix timestamps and
+	// durations in seconds
+	mtg := struct {
+		ID              string        `json:"id"`
+		Room            string        `json:"room"`
+		StartTime       int64         `json:"start_time"`
+		Duration        int64         `json:"duration"`
+		Ongoing         bool          `json:"ongoing"`
+		MaxParticipants int           `json:"max_participants"`
+		Participants    []Participant `json:"participants"`
+	}{}
+
+	if err := json.Unmarshal(data, &mtg); err != nil {
+		return err
+	}
+
+	m.ID = mtg.ID
+	m.Room = mtg.Room
+	m.StartTime = time.Unix(mtg.StartTime, 0)
+	m.Duration = time.Duration(mtg.Duration) * time.Second
+	m.Ongoing = mtg.Ongoing
+	m.MaxParticipants = mtg.MaxParticipants
+	m.Participants = mtg.Participants
+	return nil
+}
+
+func (p *Participant) UnmarshalJSON(data []byte) error {
+	pt := struct {
+		ParticipantID string `json:"participant_id"`
+		UserID        string `json:"user_id"`
+		UserName      string `json:"user_name"`
+		JoinTime      int64  `json:"join_time"`
+		Duration      int64  `json:"duration"`
+	}{}
+
+	if err := json.Unmarshal(data, &pt); err != nil {
+		return err
+	}
+
+	p.ParticipantID = pt.ParticipantID
+	p.UserID = pt.UserID
+	p.UserName = pt.UserName
+	p.JoinTime = time.Unix(pt.JoinTime, 0)
+	p.Duration = time.Duration(pt.Duration) * time.Second
+	return nil
+}
+
+// GetEndTime retrieves the time the meeting ended, or
+// the time of its last update if it is still ongoing
+func (m *Meeting) GetEndTime() time.Time {
+	return m.StartTime.Add(m.Duration)
+}
+
+// GetLeaveTime retrieves the time the participant left the meeting
+func (p *Participant) GetLeaveTime() time.Time {
+	return p.JoinTime.Add(p.Duration)
+}
+
+func meetingsEndpointWithParams(apiURL string, query url.Values, paths ...string) (string, error) {
+	u, err := meetingsURL(apiURL, paths...)
+	if err != nil {
+		return "", err
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+func meetingsEndpoint(apiURL string, paths ...string) (string, error) {
+	u, err := meetingsURL(apiURL, paths...)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func meetingsURL(apiURL string, paths ...string) (*url.URL, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, errors.NewErrFailedEndpointConstruction(err)
+	}
+
+	allPaths := append([]string{u.Path, "meetings"}, paths...)
+	u.Path = path.Join(allPaths...)
+	return u, nil
+}
@@ -0,0 +1,61 @@
+package meeting
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/pagination"
+	"net/url"
+	"strconv"
+)
+
+type GetParticipantsParams struct {
+	// Limit is the maximum number of participants to
+	// retrieve. If 0, all participants are retrieved.
+	Limit int
+	// JoinedAfter only retrieves participants who joined
+	// after the participant with this session ID
+	JoinedAfter string
+}
+
+// GetParticipants returns the participants of the given meeting
+func GetParticipants(ctx context.Context, creds auth.Creds, meetingID string, params *GetParticipantsParams) ([]Participant, error) {
+	if params == nil {
+		params = &GetParticipantsParams{}
+	}
+	if err := pagination.ValidateLimit(params.Limit); err != nil {
+		return nil, err
+	}
+	return pagination.Collect(params.Limit, params.JoinedAfter, func(cursor string, limit int) (*pagination.Page[Participant], error) {
+		return doGetParticipants(ctx, creds, meetingID, cursor, limit)
+	}, func(p Participant) string {
+		return p.ParticipantID
+	})
+}
+
+func doGetParticipants(ctx context.Context, creds auth.Creds, meetingID string, cursor string, limit int) (*pagination.Page[Participant], error) {
+	// This endpoint's cursor is named differently
+	// from other list endpoints
+	q := url.Values{}
+	if cursor != "" {
+		q.Set("joined_after", cursor)
+	}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+	endpoint, err := meetingsEndpointWithParams(creds.APIURL, q, meetingID, "participants")
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := doGet(ctx, creds, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var page pagination.Page[Participant]
+	if err := json.Unmarshal(resBody, &page); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &page, nil
+}
@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/meeting"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const meetingBody = `
+	{
+		"id": "3b1fd9d2-9d1e-4c65-8f8e-f5a3e1e2f0c1",
+		"room": "w2pp2cf4kltgFACPKXmX",
+		"start_time": 1672606399,
+		"duration": 1813,
+		"ongoing": false,
+		"max_participants": 2,
+		"participants": [
+			{
+				"user_id": null,
+				"participant_id": "d61cd7b2-a273-42b4-89bd-be763fd562c1",
+				"user_name": "Moishe",
+				"join_time": 1672606399,
+				"duration": 1813
+			}
+		]
+	}
+`
+
+func TestGetOne(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name               string
+		dailyResStatusCode int
+		dailyResBody       string
+		wantMeeting        meeting.Meeting
+		wantErr            error
+	}{
+		{
+			name:               "bad status code",
+			dailyResStatusCode: http.StatusNotFound,
+			dailyResBody:       "{}",
+			wantErr:            errors.ErrFailedAPICall,
+		},
+		{
+			name:               "meeting retrieved",
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody:       meetingBody,
+			wantMeeting: meeting.Meeting{
+				ID:              "3b1fd9d2-9d1e-4c65-8f8e-f5a3e1e2f0c1",
+				Room:            "w2pp2cf4kltgFACPKXmX",
+				StartTime:       time.Unix(1672606399, 0),
+				Duration:        1813 * time.Second,
+				MaxParticipants: 2,
+				Participants: []meeting.Participant{
+					{
+						ParticipantID: "d61cd7b2-a273-42b4-89bd-be763fd562c1",
+						UserName:      "Moishe",
+						JoinTime:      time.Unix(1672606399, 0),
+						Duration:      1813 * time.Second,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/meetings/some-id", r.URL.Path)
+				w.WriteHeader(tc.dailyResStatusCode)
+				_, err := w.Write([]byte(tc.dailyResBody))
+				require.NoError(t, err)
+			}))
+
+			defer testServer.Close()
+
+			gotMeeting, gotErr := meeting.GetOne(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-id")
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			if gotErr == nil {
+				require.Equal(t, tc.wantMeeting, *gotMeeting)
+				require.Equal(t, time.Unix(1672608212, 0), gotMeeting.GetEndTime())
+			}
+		})
+	}
+}
+
+func TestGetMany(t *testing.T) {
+	t.Parallel()
+	ongoing := false
+	params := &meeting.GetManyParams{
+		Limit:          10,
+		Room:           "w2pp2cf4kltgFACPKXmX",
+		TimeframeStart: time.Unix(1672531200, 0),
+		TimeframeEnd:   time.Unix(1675209600, 0),
+		Ongoing:        &ongoing,
+	}
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/meetings", r.URL.Path)
+		q := r.URL.Query()
+		require.Equal(t, "10", q.Get("limit"))
+		require.Equal(t, "w2pp2cf4kltgFACPKXmX", q.Get("room"))
+		require.Equal(t, "1672531200", q.Get("timeframe_start"))
+		require.Equal(t, "1675209600", q.Get("timeframe_end"))
+		require.Equal(t, "false", q.Get("ongoing"))
+		_, err := fmt.Fprintf(w, `{"total_count":1,"data":[%s]}`, meetingBody)
+		require.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	gotMeetings, gotErr := meeting.GetMany(context.Background(), auth.Creds{
+		APIKey: "someKey",
+		APIURL: testServer.URL,
+	}, params)
+	require.NoError(t, gotErr)
+	require.Len(t, gotMeetings, 1)
+	require.Equal(t, 1813*time.Second, gotMeetings[0].Duration)
+}
+
+func TestGetParticipants(t *testing.T) {
+	t.Parallel()
+	const totalParticipants = 150
+	var gotCursors []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/meetings/some-id/participants", r.URL.Path)
+		q := r.URL.Query()
+		gotCursors = append(gotCursors, q.Get("joined_after"))
+
+		start := 0
+		if after := q.Get("joined_after"); after != "" {
+			n, err := strconv.Atoi(strings.TrimPrefix(after, "p-"))
+			require.NoError(t, err)
+			start = n + 1
+		}
+		limit, err := strconv.Atoi(q.Get("limit"))
+		require.NoError(t, err)
+		var data []string
+		for i := start; i < start+limit && i < totalParticipants; i++ {
+			data = append(data, fmt.Sprintf(`{"participant_id":"p-%d","duration":%d}`, i, i))
+		}
+		_, err = fmt.Fprintf(w, `{"total_count":%d,"data":[%s]}`, totalParticipants, strings.Join(data, ","))
+		require.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	gotParticipants, gotErr := meeting.GetParticipants(context.Background(), auth.Creds{
+		APIKey: "someKey",
+		APIURL: testServer.URL,
+	}, "some-id", nil)
+	require.NoError(t, gotErr)
+	require.Len(t, gotParticipants, totalParticipants)
+	require.Equal(t, []string{"", "p-99"}, gotCursors)
+	require.Equal(t, 149*time.Second, gotParticipants[149].Duration)
+}
@@ -0,0 +1,69 @@
+// Package pagination handles cursor-based pagination of Daily's list endpoints
+package pagination
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// MaxPageSize is the largest page Daily returns from list endpoints
+const MaxPageSize = 100
+
+// Page is a single page of results from a Daily list endpoint
+type Page[T any] struct {
+	TotalCount int `json:"total_count"`
+	Data       []T `json:"data"`
+}
+
+// FetchFunc retrieves a single page of up to limit results,
+// starting after the item with the given cursor ID. An empty
+// cursor retrieves the first page.
+type FetchFunc[T any] func(cursor string, limit int) (*Page[T], error)
+
+// Collect retrieves results page by page, using the ID of the
+// last result of each page as the cursor for the next one.
+// It stops once limit results have been retrieved, or when there
+// are no more results. A limit of 0 retrieves all results.
+func Collect[T any](limit int, startingAfter string, fetch FetchFunc[T], idOf func(T) string) ([]T, error) {
+	var all []T
+	cursor := startingAfter
+	for {
+		pageSize := MaxPageSize
+		if limit > 0 && limit-len(all) < pageSize {
+			pageSize = limit - len(all)
+		}
+		page, err := fetch(cursor, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Data...)
+
+		// A short page means there is nothing more to retrieve
+		l := len(page.Data)
+		if l == 0 || l < pageSize || (limit > 0 && len(all) >= limit) {
+			break
+		}
+		cursor = idOf(page.Data[l-1])
+	}
+	return all, nil
+}
+
+// SetQueryParams sets the given cursor and limit on the
+// given query values, if they are set.
+func SetQueryParams(q url.Values, cursor string, limit int) {
+	if cursor != "" {
+		q.Set("starting_after", cursor)
+	}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+}
+
+// ValidateLimit checks that the given limit can be used with Collect
+func ValidateLimit(limit int) error {
+	if limit < 0 {
+		return fmt.Errorf("limit cannot be negative: %d", limit)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package daily
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/room"
+)
+
+// UpdateParticipantPermissions updates the permissions of participants
+// in the given Daily room, keyed by session ID or room.AllParticipants
+func (d *Daily) UpdateParticipantPermissions(ctx context.Context, roomName string, perms map[string]room.Permissions) error {
+	return room.UpdatePermissions(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, perms)
+}
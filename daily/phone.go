@@ -0,0 +1,43 @@
+package daily
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/phone"
+)
+
+// GetAvailablePhoneNumbers returns phone numbers which can be
+// purchased, matching the given filters, if any
+func (d *Daily) GetAvailablePhoneNumbers(ctx context.Context, params *phone.ListAvailableParams) ([]phone.AvailableNumber, error) {
+	return phone.ListAvailable(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, params)
+}
+
+// BuyPhoneNumber purchases the given phone number. If no
+// number is given, Daily picks an available one.
+func (d *Daily) BuyPhoneNumber(ctx context.Context, number string) (*phone.PurchasedNumber, error) {
+	return phone.Buy(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, number)
+}
+
+// GetPurchasedPhoneNumbers returns phone numbers
+// purchased by the Daily domain
+func (d *Daily) GetPurchasedPhoneNumbers(ctx context.Context, params *phone.ListPurchasedParams) ([]phone.PurchasedNumber, error) {
+	return phone.ListPurchased(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, params)
+}
+
+// ReleasePhoneNumber releases the purchased
+// phone number with the given ID
+func (d *Daily) ReleasePhoneNumber(ctx context.Context, numberID string) error {
+	return phone.Release(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, numberID)
+}
@@ -0,0 +1,74 @@
+package phone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"net/url"
+	"regexp"
+)
+
+var (
+	// areaCodeRegex matches a three-digit North American area code
+	areaCodeRegex = regexp.MustCompile(`^[2-9][0-9]{2}$`)
+	// regionRegex matches a two-letter region code, e.g. "CA"
+	regionRegex = regexp.MustCompile(`^[A-Za-z]{2}$`)
+)
+
+// ListAvailableParams represents optional filters
+// for listing phone numbers available to purchase
+type ListAvailableParams struct {
+	// Region is the two-letter state or province code, e.g. "CA"
+	Region string
+	// AreaCode is the three-digit area code, e.g. "415"
+	AreaCode string
+}
+
+type availableNumbersResponse struct {
+	Data []AvailableNumber `json:"data"`
+}
+
+// ListAvailable returns phone numbers which can be purchased,
+// matching the given filters, if any
+func ListAvailable(ctx context.Context, creds auth.Creds, params *ListAvailableParams) ([]AvailableNumber, error) {
+	if params == nil {
+		params = &ListAvailableParams{}
+	}
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	q := url.Values{}
+	if params.Region != "" {
+		q.Set("region", params.Region)
+	}
+	if params.AreaCode != "" {
+		q.Set("areacode", params.AreaCode)
+	}
+	endpoint, err := phoneEndpoint(creds.APIURL, q, "list-available-numbers")
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := doPhoneRequest(ctx, creds, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list available phone numbers: %w", err)
+	}
+
+	var res availableNumbersResponse
+	if err := json.Unmarshal(resBody, &res); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return res.Data, nil
+}
+
+func (p ListAvailableParams) validate() error {
+	if p.Region != "" && !regionRegex.MatchString(p.Region) {
+		return NewErrInvalidParams(fmt.Errorf("region must be a two-letter code, e.g. CA: '%s'", p.Region))
+	}
+	if p.AreaCode != "" && !areaCodeRegex.MatchString(p.AreaCode) {
+		return NewErrInvalidParams(fmt.Errorf("area code must be three digits, e.g. 415: '%s'", p.AreaCode))
+	}
+	return nil
+}
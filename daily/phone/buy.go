@@ -0,0 +1,35 @@
+package phone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+)
+
+type buyBody struct {
+	Number string `json:"number,omitempty"`
+}
+
+// Buy purchases the given phone number, which must be in E.164
+// format. If no number is given, Daily picks an available one.
+func Buy(ctx context.Context, creds auth.Creds, number string) (*PurchasedNumber, error) {
+	if number != "" && !IsE164(number) {
+		return nil, NewErrInvalidParams(fmt.Errorf("phone number must be in E.164 format, e.g. +12025550123: '%s'", number))
+	}
+	endpoint, err := phoneEndpoint(creds.APIURL, nil, "buy-phone-number")
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := doPhoneRequest(ctx, creds, "POST", endpoint, buyBody{Number: number})
+	if err != nil {
+		return nil, fmt.Errorf("failed to buy phone number: %w", err)
+	}
+
+	var purchased PurchasedNumber
+	if err := json.Unmarshal(resBody, &purchased); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &purchased, nil
+}
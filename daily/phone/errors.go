@@ -0,0 +1,21 @@
+package phone
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrFailUnmarshal = errors.New("failed to unmarshal response body into phone number")
+	// ErrInvalidParams is returned when parameters given for a
+	// phone number operation are not accepted by Daily.
+	ErrInvalidParams = errors.New("invalid phone number params")
+)
+
+func NewErrFailUnmarshal(unmarshalErr error) error {
+	return fmt.Errorf("%s: %w", unmarshalErr, ErrFailUnmarshal)
+}
+
+func NewErrInvalidParams(err error) error {
+	return fmt.Errorf("%s: %w", err, ErrInvalidParams)
+}
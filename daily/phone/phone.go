@@ -0,0 +1,96 @@
+// Package phone handles phone numbers which can be
+// purchased for PSTN dial-in and dial-out
+package phone
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+)
+
+// e164Regex matches phone numbers in E.164 format, e.g. "+12025550123"
+var e164Regex = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// IsE164 reports whether the given phone number
+// is in E.164 format, e.g. "+12025550123"
+func IsE164(number string) bool {
+	return e164Regex.MatchString(number)
+}
+
+// AvailableNumber is a phone number which can be purchased
+type AvailableNumber struct {
+	Number string `json:"number"`
+	Region string `json:"region"`
+	City   string `json:"city"`
+}
+
+// PurchasedNumber is a phone number purchased by the domain
+type PurchasedNumber struct {
+	ID          string `json:"id"`
+	Number      string `json:"number"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	CreatedDate string `json:"created_date"`
+}
+
+// doPhoneRequest sends the given body, if any, to the
+// given phone number endpoint and returns the response body
+func doPhoneRequest(ctx context.Context, creds auth.Creds, method string, endpoint string, body interface{}) ([]byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		bodyBlob, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = bytes.NewBuffer(bodyBlob)
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s request to phone number endpoint: %w", method, err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make phone number request: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+	return resBody, nil
+}
+
+func phoneEndpoint(apiURL string, query url.Values, paths ...string) (string, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return "", errors.NewErrFailedEndpointConstruction(err)
+	}
+
+	allPaths := append([]string{u.Path}, paths...)
+	u.Path = path.Join(allPaths...)
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+	return u.String(), nil
+}
@@ -0,0 +1,53 @@
+package phone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/pagination"
+	"net/url"
+)
+
+type ListPurchasedParams struct {
+	// Limit is the maximum number of phone numbers to
+	// retrieve. If 0, all purchased numbers are retrieved.
+	Limit         int
+	StartingAfter string
+}
+
+// ListPurchased returns phone numbers purchased by the domain.
+// If no params are given, all purchased numbers are returned.
+func ListPurchased(ctx context.Context, creds auth.Creds, params *ListPurchasedParams) ([]PurchasedNumber, error) {
+	if params == nil {
+		params = &ListPurchasedParams{}
+	}
+	if err := pagination.ValidateLimit(params.Limit); err != nil {
+		return nil, NewErrInvalidParams(err)
+	}
+	return pagination.Collect(params.Limit, params.StartingAfter, func(cursor string, limit int) (*pagination.Page[PurchasedNumber], error) {
+		return doListPurchased(ctx, creds, cursor, limit)
+	}, func(n PurchasedNumber) string {
+		return n.ID
+	})
+}
+
+func doListPurchased(ctx context.Context, creds auth.Creds, cursor string, limit int) (*pagination.Page[PurchasedNumber], error) {
+	q := url.Values{}
+	pagination.SetQueryParams(q, cursor, limit)
+	endpoint, err := phoneEndpoint(creds.APIURL, q, "purchased-phone-numbers")
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := doPhoneRequest(ctx, creds, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list purchased phone numbers: %w", err)
+	}
+
+	var page pagination.Page[PurchasedNumber]
+	if err := json.Unmarshal(resBody, &page); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &page, nil
+}
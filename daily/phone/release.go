@@ -0,0 +1,25 @@
+package phone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+)
+
+// Release releases the purchased phone number with the given ID.
+// The number can no longer be used for dial-in or dial-out.
+func Release(ctx context.Context, creds auth.Creds, numberID string) error {
+	if numberID == "" {
+		return NewErrInvalidParams(errors.New("phone number ID is required"))
+	}
+	endpoint, err := phoneEndpoint(creds.APIURL, nil, "release-phone-number", numberID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := doPhoneRequest(ctx, creds, "DELETE", endpoint, nil); err != nil {
+		return fmt.Errorf("failed to release phone number: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/phone"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListAvailable(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name        string
+		params      *phone.ListAvailableParams
+		retCode     int
+		retBody     string
+		wantQuery   string
+		wantNumbers []phone.AvailableNumber
+		wantErr     error
+		wantCalled  bool
+	}{
+		{
+			name:    "no filters",
+			retCode: http.StatusOK,
+			retBody: `{"total_count":2,"data":[
+				{"number":"+14155550100","region":"CA","city":"San Francisco"},
+				{"number":"+12125550100","region":"NY","city":"New York"}
+			]}`,
+			wantNumbers: []phone.AvailableNumber{
+				{Number: "+14155550100", Region: "CA", City: "San Francisco"},
+				{Number: "+12125550100", Region: "NY", City: "New York"},
+			},
+			wantCalled: true,
+		},
+		{
+			name: "region and area code",
+			params: &phone.ListAvailableParams{
+				Region:   "CA",
+				AreaCode: "415",
+			},
+			retCode:   http.StatusOK,
+			retBody:   `{"total_count":1,"data":[{"number":"+14155550100","region":"CA","city":"San Francisco"}]}`,
+			wantQuery: "areacode=415&region=CA",
+			wantNumbers: []phone.AvailableNumber{
+				{Number: "+14155550100", Region: "CA", City: "San Francisco"},
+			},
+			wantCalled: true,
+		},
+		{
+			name: "invalid region",
+			params: &phone.ListAvailableParams{
+				Region: "California",
+			},
+			wantErr: phone.ErrInvalidParams,
+		},
+		{
+			name: "invalid area code",
+			params: &phone.ListAvailableParams{
+				AreaCode: "41",
+			},
+			wantErr: phone.ErrInvalidParams,
+		},
+		{
+			name:       "bad status code",
+			retCode:    http.StatusBadRequest,
+			retBody:    `{"error":"invalid-request-error"}`,
+			wantErr:    errors.ErrFailedAPICall,
+			wantCalled: true,
+		},
+		{
+			name:       "bad body",
+			retCode:    http.StatusOK,
+			retBody:    `{"data":{}}`,
+			wantErr:    phone.ErrFailUnmarshal,
+			wantCalled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var gotCalled bool
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotCalled = true
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "/list-available-numbers", r.URL.Path)
+				require.Equal(t, tc.wantQuery, r.URL.RawQuery)
+				w.WriteHeader(tc.retCode)
+				_, err := w.Write([]byte(tc.retBody))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			gotNumbers, gotErr := phone.ListAvailable(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, tc.params)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantNumbers, gotNumbers)
+			require.Equal(t, tc.wantCalled, gotCalled)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/phone"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBuy(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name       string
+		number     string
+		retCode    int
+		retBody    string
+		wantBody   string
+		wantNumber *phone.PurchasedNumber
+		wantErr    error
+		wantCalled bool
+	}{
+		{
+			name:     "given number",
+			number:   "+14155550100",
+			retCode:  http.StatusOK,
+			retBody:  `{"id":"0f2b1c5e-8c1d-4f6a-9d0e-3b7a2c4d5e6f","number":"+14155550100"}`,
+			wantBody: `{"number":"+14155550100"}`,
+			wantNumber: &phone.PurchasedNumber{
+				ID:     "0f2b1c5e-8c1d-4f6a-9d0e-3b7a2c4d5e6f",
+				Number: "+14155550100",
+			},
+			wantCalled: true,
+		},
+		{
+			name:     "any number",
+			retCode:  http.StatusOK,
+			retBody:  `{"id":"0f2b1c5e-8c1d-4f6a-9d0e-3b7a2c4d5e6f","number":"+12125550100"}`,
+			wantBody: `{}`,
+			wantNumber: &phone.PurchasedNumber{
+				ID:     "0f2b1c5e-8c1d-4f6a-9d0e-3b7a2c4d5e6f",
+				Number: "+12125550100",
+			},
+			wantCalled: true,
+		},
+		{
+			name:    "number not in E.164 format",
+			number:  "(415) 555-0100",
+			wantErr: phone.ErrInvalidParams,
+		},
+		{
+			name:       "bad status code",
+			number:     "+14155550100",
+			retCode:    http.StatusBadRequest,
+			wantBody:   `{"number":"+14155550100"}`,
+			wantErr:    errors.ErrFailedAPICall,
+			wantCalled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var gotCalled bool
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotCalled = true
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, "/buy-phone-number", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantBody, string(body))
+				w.WriteHeader(tc.retCode)
+				_, err = w.Write([]byte(tc.retBody))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			gotNumber, gotErr := phone.Buy(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, tc.number)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantNumber, gotNumber)
+			require.Equal(t, tc.wantCalled, gotCalled)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/phone"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestListPurchased(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name         string
+		params       *phone.ListPurchasedParams
+		numPurchased int
+		retCode      int
+		wantIDs      []string
+		wantErr      error
+	}{
+		{
+			name:         "all across pages",
+			numPurchased: 150,
+			retCode:      http.StatusOK,
+			wantIDs:      purchasedIDs(0, 150),
+		},
+		{
+			name:         "limit and starting after",
+			params:       &phone.ListPurchasedParams{Limit: 3, StartingAfter: "number-4"},
+			numPurchased: 10,
+			retCode:      http.StatusOK,
+			wantIDs:      purchasedIDs(5, 8),
+		},
+		{
+			name:    "negative limit",
+			params:  &phone.ListPurchasedParams{Limit: -1},
+			wantErr: phone.ErrInvalidParams,
+		},
+		{
+			name:    "bad status code",
+			retCode: http.StatusForbidden,
+			wantErr: errors.ErrFailedAPICall,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "/purchased-phone-numbers", r.URL.Path)
+				if tc.retCode != http.StatusOK {
+					w.WriteHeader(tc.retCode)
+					return
+				}
+
+				q := r.URL.Query()
+				limit, err := strconv.Atoi(q.Get("limit"))
+				require.NoError(t, err)
+				start := 0
+				if after := q.Get("starting_after"); after != "" {
+					n, err := strconv.Atoi(strings.TrimPrefix(after, "number-"))
+					require.NoError(t, err)
+					start = n + 1
+				}
+				end := start + limit
+				if end > tc.numPurchased {
+					end = tc.numPurchased
+				}
+
+				var data []string
+				for _, id := range purchasedIDs(start, end) {
+					data = append(data, fmt.Sprintf(`{"id":"%s","number":"+14155550100","type":"local"}`, id))
+				}
+				_, err = fmt.Fprintf(w, `{"total_count":%d,"data":[%s]}`, tc.numPurchased, strings.Join(data, ","))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			gotNumbers, gotErr := phone.ListPurchased(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, tc.params)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			var gotIDs []string
+			for _, n := range gotNumbers {
+				gotIDs = append(gotIDs, n.ID)
+			}
+			require.Equal(t, tc.wantIDs, gotIDs)
+		})
+	}
+}
+
+func purchasedIDs(start, end int) []string {
+	var ids []string
+	for i := start; i < end; i++ {
+		ids = append(ids, fmt.Sprintf("number-%d", i))
+	}
+	return ids
+}
@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/phone"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRelease(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name       string
+		numberID   string
+		retCode    int
+		wantErr    error
+		wantCalled bool
+	}{
+		{
+			name:       "success",
+			numberID:   "0f2b1c5e-8c1d-4f6a-9d0e-3b7a2c4d5e6f",
+			retCode:    http.StatusOK,
+			wantCalled: true,
+		},
+		{
+			name:    "no ID",
+			wantErr: phone.ErrInvalidParams,
+		},
+		{
+			name:       "bad status code",
+			numberID:   "0f2b1c5e-8c1d-4f6a-9d0e-3b7a2c4d5e6f",
+			retCode:    http.StatusNotFound,
+			wantErr:    errors.ErrFailedAPICall,
+			wantCalled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var gotCalled bool
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotCalled = true
+				require.Equal(t, http.MethodDelete, r.Method)
+				require.Equal(t, "/release-phone-number/"+tc.numberID, r.URL.Path)
+				w.WriteHeader(tc.retCode)
+				_, err := w.Write([]byte(`{"deleted":true}`))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			gotErr := phone.Release(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, tc.numberID)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantCalled, gotCalled)
+		})
+	}
+}
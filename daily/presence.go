@@ -0,0 +1,33 @@
+package daily
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/presence"
+	"time"
+)
+
+// GetPresence returns the participants currently present in
+// all Daily rooms of the domain, keyed by room name
+func (d *Daily) GetPresence(ctx context.Context) (map[string][]presence.Participant, error) {
+	return presence.GetAll(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	})
+}
+
+// GetRoomPresence returns the participants currently
+// present in the given Daily room
+func (d *Daily) GetRoomPresence(ctx context.Context, roomName string) ([]presence.Participant, error) {
+	return presence.GetForRoom(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName)
+}
+
+// WatchPresence polls presence of all Daily rooms every interval and
+// emits join, leave and room emptied events as they are detected.
+// The returned channel is closed once the given context is cancelled.
+func (d *Daily) WatchPresence(ctx context.Context, interval time.Duration) (<-chan presence.Event, error) {
+	return presence.Watch(ctx, interval, d.GetPresence)
+}
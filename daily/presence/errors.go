@@ -0,0 +1,22 @@
+package presence
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrFailUnmarshal = errors.New("failed to unmarshal response body into Participant")
+	// ErrInvalidInterval is returned when presence would
+	// be polled without waiting between fetches.
+	ErrInvalidInterval = errors.New("polling interval must be positive")
+)
+
+func NewErrFailUnmarshal(unmarshalErr error) error {
+	return fmt.Errorf("%s: %w", unmarshalErr, ErrFailUnmarshal)
+}
+
+func NewErrInvalidInterval(interval time.Duration) error {
+	return fmt.Errorf("interval is %s: %w", interval, ErrInvalidInterval)
+}
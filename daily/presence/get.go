@@ -0,0 +1,85 @@
+package presence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+)
+
+type getRoomResponse struct {
+	TotalCount int           `json:"total_count"`
+	Data       []Participant `json:"data"`
+}
+
+// GetAll returns the participants present in all rooms
+// of the domain, keyed by room name. Rooms without
+// any participants are not included.
+func GetAll(ctx context.Context, creds auth.Creds) (map[string][]Participant, error) {
+	endpoint, err := presenceEndpoint(creds.APIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := doGetPresence(ctx, creds, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var presence map[string][]Participant
+	if err := json.Unmarshal(resBody, &presence); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return presence, nil
+}
+
+// GetForRoom returns the participants present in the given room
+func GetForRoom(ctx context.Context, creds auth.Creds, roomName string) ([]Participant, error) {
+	endpoint, err := roomPresenceEndpoint(creds.APIURL, roomName)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := doGetPresence(ctx, creds, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var res getRoomResponse
+	if err := json.Unmarshal(resBody, &res); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return res.Data, nil
+}
+
+func doGetPresence(ctx context.Context, creds auth.Creds, endpoint string) ([]byte, error) {
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request to presence endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get presence: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+	return resBody, nil
+}
@@ -0,0 +1,45 @@
+// Package presence handles retrieving which participants are currently in Daily rooms
+package presence
+
+import (
+	"github.com/lazeratops/daily-go/daily/errors"
+	"net/url"
+	"path"
+	"time"
+)
+
+// Participant represents a participant currently present in a Daily room
+type Participant struct {
+	// ID is the participant's session ID
+	ID       string    `json:"id"`
+	Room     string    `json:"room"`
+	UserID   string    `json:"userId"`
+	UserName string    `json:"userName"`
+	JoinTime time.Time `json:"joinTime"`
+	// Duration is in seconds, see GetDuration
+	Duration int `json:"duration"`
+}
+
+// GetDuration retrieves how long the participant has been in the room
+func (p *Participant) GetDuration() time.Duration {
+	return time.Duration(p.Duration) * time.Second
+}
+
+func presenceEndpoint(apiURL string) (string, error) {
+	return apiEndpoint(apiURL, "presence")
+}
+
+func roomPresenceEndpoint(apiURL string, roomName string) (string, error) {
+	return apiEndpoint(apiURL, "rooms", roomName, "presence")
+}
+
+func apiEndpoint(apiURL string, paths ...string) (string, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return "", errors.NewErrFailedEndpointConstruction(err)
+	}
+
+	allPaths := append([]string{u.Path}, paths...)
+	u.Path = path.Join(allPaths...)
+	return u.String(), nil
+}
@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/presence"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetAll(t *testing.T) {
+	t.Parallel()
+	joinTime, err := time.Parse(time.RFC3339, "2023-01-01T20:53:19.000Z")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name               string
+		dailyResStatusCode int
+		dailyResBody       string
+		wantPresence       map[string][]presence.Participant
+		wantErr            error
+	}{
+		{
+			name:               "bad status code",
+			dailyResStatusCode: http.StatusBadRequest,
+			dailyResBody:       "{}",
+			wantErr:            errors.ErrFailedAPICall,
+		},
+		{
+			name:               "presence retrieved",
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody: `
+				{
+					"w2pp2cf4kltgFACPKXmX": [
+						{
+							"room": "w2pp2cf4kltgFACPKXmX",
+							"id": "d61cd7b2-a273-42b4-89bd-be763fd562c1",
+							"userId": "pbZ+ismP7dk=",
+							"userName": "Moishe",
+							"joinTime": "2023-01-01T20:53:19.000Z",
+							"duration": 2312
+						}
+					]
+				}
+			`,
+			wantPresence: map[string][]presence.Participant{
+				"w2pp2cf4kltgFACPKXmX": {
+					{
+						ID:       "d61cd7b2-a273-42b4-89bd-be763fd562c1",
+						Room:     "w2pp2cf4kltgFACPKXmX",
+						UserID:   "pbZ+ismP7dk=",
+						UserName: "Moishe",
+						JoinTime: joinTime,
+						Duration: 2312,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/presence", r.URL.Path)
+				w.WriteHeader(tc.dailyResStatusCode)
+				_, err := w.Write([]byte(tc.dailyResBody))
+				require.NoError(t, err)
+			}))
+
+			defer testServer.Close()
+
+			gotPresence, gotErr := presence.GetAll(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			})
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantPresence, gotPresence)
+		})
+	}
+}
+
+func TestGetForRoom(t *testing.T) {
+	t.Parallel()
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/rooms/w2pp2cf4kltgFACPKXmX/presence", r.URL.Path)
+		_, err := w.Write([]byte(`
+			{
+				"total_count": 2,
+				"data": [
+					{"room": "w2pp2cf4kltgFACPKXmX", "id": "d61cd7b2-a273-42b4-89bd-be763fd562c1", "userName": "Moishe", "duration": 60},
+					{"room": "w2pp2cf4kltgFACPKXmX", "id": "5e3cf703-5547-47d6-a371-37b1f0b4427f", "userName": "Nina", "duration": 30}
+				]
+			}
+		`))
+		require.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	gotParticipants, gotErr := presence.GetForRoom(context.Background(), auth.Creds{
+		APIKey: "someKey",
+		APIURL: testServer.URL,
+	}, "w2pp2cf4kltgFACPKXmX")
+	require.NoError(t, gotErr)
+	require.Len(t, gotParticipants, 2)
+	require.Equal(t, "Nina", gotParticipants[1].UserName)
+	require.Equal(t, time.Minute, gotParticipants[0].GetDuration())
+}
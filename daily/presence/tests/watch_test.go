@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"github.com/lazeratops/daily-go/daily/presence"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestWatch(t *testing.T) {
+	t.Parallel()
+	alice := presence.Participant{ID: "a", Room: "room-1", UserName: "Alice"}
+	bob := presence.Participant{ID: "b", Room: "room-1", UserName: "Bob", JoinTime: time.Unix(10, 0)}
+	carol := presence.Participant{ID: "c", Room: "room-2", UserName: "Carol"}
+	fetchErr := errors.New("boom")
+
+	snapshots := []struct {
+		presence map[string][]presence.Participant
+		err      error
+	}{
+		{presence: map[string][]presence.Participant{"room-1": {bob, alice}}},
+		{err: fetchErr},
+		{presence: map[string][]presence.Participant{"room-1": {bob}, "room-2": {carol}}},
+		{presence: map[string][]presence.Participant{"room-2": {carol}}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls int
+	events, err := presence.Watch(ctx, time.Millisecond, func(ctx context.Context) (map[string][]presence.Participant, error) {
+		s := snapshots[calls]
+		if calls < len(snapshots)-1 {
+			calls++
+		}
+		return s.presence, s.err
+	})
+	require.NoError(t, err)
+
+	wantEvents := []presence.Event{
+		presence.ParticipantJoined{Participant: alice},
+		presence.ParticipantJoined{Participant: bob},
+		presence.FetchFailed{Err: fetchErr, Retry: time.Millisecond},
+		presence.ParticipantLeft{Participant: alice},
+		presence.ParticipantJoined{Participant: carol},
+		presence.ParticipantLeft{Participant: bob},
+		presence.RoomEmptied{Room: "room-1"},
+	}
+	for _, want := range wantEvents {
+		select {
+		case got := <-events:
+			require.Equal(t, want, got)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %#v", want)
+		}
+	}
+
+	// No further changes are reported, and the channel
+	// is closed once the context is cancelled.
+	cancel()
+	for e := range events {
+		t.Fatalf("unexpected event %#v", e)
+	}
+}
+
+func TestWatchBackoff(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := presence.Watch(ctx, time.Millisecond, func(ctx context.Context) (map[string][]presence.Participant, error) {
+		return nil, errors.New("boom")
+	})
+	require.NoError(t, err)
+
+	var gotRetries []time.Duration
+	for i := 0; i < 4; i++ {
+		e := (<-events).(presence.FetchFailed)
+		gotRetries = append(gotRetries, e.Retry)
+	}
+	require.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond, 8 * time.Millisecond}, gotRetries)
+}
+
+func TestWatchInvalidInterval(t *testing.T) {
+	t.Parallel()
+	for _, interval := range []time.Duration{0, -time.Second} {
+		var calls int
+		events, err := presence.Watch(context.Background(), interval, func(ctx context.Context) (map[string][]presence.Participant, error) {
+			calls++
+			return nil, nil
+		})
+		require.ErrorIs(t, err, presence.ErrInvalidInterval)
+		require.Nil(t, events)
+		require.Zero(t, calls)
+	}
+}
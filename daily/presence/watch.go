@@ -0,0 +1,178 @@
+package presence
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// maxBackoffFactor caps retries after consecutive failed
+// fetches at this many times the polling interval
+const maxBackoffFactor = 32
+
+// Event is a change in presence emitted by Watch. It is one of
+// ParticipantJoined, ParticipantLeft, RoomEmptied or FetchFailed.
+type Event interface {
+	isEvent()
+}
+
+// ParticipantJoined is emitted when a participant
+// appears in a room
+type ParticipantJoined struct {
+	Participant Participant
+}
+
+// ParticipantLeft is emitted when a participant
+// is no longer present in a room
+type ParticipantLeft struct {
+	Participant Participant
+}
+
+// RoomEmptied is emitted after the last participant
+// has left a room
+type RoomEmptied struct {
+	Room string
+}
+
+// FetchFailed is emitted when presence could not be
+// retrieved. The next attempt is made after Retry.
+type FetchFailed struct {
+	Err   error
+	Retry time.Duration
+}
+
+func (ParticipantJoined) isEvent() {}
+func (ParticipantLeft) isEvent()   {}
+func (RoomEmptied) isEvent()       {}
+func (FetchFailed) isEvent()       {}
+
+// FetchFunc retrieves the participants present in
+// all rooms, keyed by room name
+type FetchFunc func(ctx context.Context) (map[string][]Participant, error)
+
+// Watch polls presence with the given fetch function every interval and
+// emits events for the differences between successive snapshots. Everyone
+// present in the first snapshot is reported as having joined. Failed
+// fetches are retried with exponential backoff. The returned channel is
+// closed once the given context is cancelled. ErrInvalidInterval is
+// returned if the interval is not positive.
+func Watch(ctx context.Context, interval time.Duration, fetch FetchFunc) (<-chan Event, error) {
+	if interval <= 0 {
+		return nil, NewErrInvalidInterval(interval)
+	}
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+
+		prev := map[string][]Participant{}
+		var failures int
+		for {
+			wait := interval
+			cur, err := fetch(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				failures++
+				wait = backoff(interval, failures)
+				if !send(ctx, events, FetchFailed{Err: err, Retry: wait}) {
+					return
+				}
+			} else {
+				failures = 0
+				for _, e := range diff(prev, cur) {
+					if !send(ctx, events, e) {
+						return
+					}
+				}
+				prev = cur
+			}
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}()
+	return events, nil
+}
+
+// send emits the given event unless the context is cancelled
+// first, and reports whether the event was sent.
+func send(ctx context.Context, events chan<- Event, e Event) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case events <- e:
+		return true
+	}
+}
+
+func backoff(interval time.Duration, failures int) time.Duration {
+	factor := 1 << (failures - 1)
+	if factor > maxBackoffFactor || factor <= 0 {
+		factor = maxBackoffFactor
+	}
+	return interval * time.Duration(factor)
+}
+
+// diff returns the events that turn the previous snapshot into
+// the current one. Events are ordered by room name, with all joins
+// and leaves of a room before it is reported as emptied.
+func diff(prev, cur map[string][]Participant) []Event {
+	rooms := make(map[string]struct{})
+	for r := range prev {
+		rooms[r] = struct{}{}
+	}
+	for r := range cur {
+		rooms[r] = struct{}{}
+	}
+	roomNames := make([]string, 0, len(rooms))
+	for r := range rooms {
+		roomNames = append(roomNames, r)
+	}
+	sort.Strings(roomNames)
+
+	var events []Event
+	for _, r := range roomNames {
+		before := bySessionID(prev[r])
+		after := bySessionID(cur[r])
+		for _, p := range sortedParticipants(cur[r]) {
+			if _, ok := before[p.ID]; !ok {
+				events = append(events, ParticipantJoined{Participant: p})
+			}
+		}
+		for _, p := range sortedParticipants(prev[r]) {
+			if _, ok := after[p.ID]; !ok {
+				events = append(events, ParticipantLeft{Participant: p})
+			}
+		}
+		if len(before) > 0 && len(after) == 0 {
+			events = append(events, RoomEmptied{Room: r})
+		}
+	}
+	return events
+}
+
+func bySessionID(participants []Participant) map[string]Participant {
+	m := make(map[string]Participant, len(participants))
+	for _, p := range participants {
+		m[p.ID] = p
+	}
+	return m
+}
+
+func sortedParticipants(participants []Participant) []Participant {
+	sorted := make([]Participant, len(participants))
+	copy(sorted, participants)
+	sort.Slice(sorted, func(i, j int) bool {
+		if !sorted[i].JoinTime.Equal(sorted[j].JoinTime) {
+			return sorted[i].JoinTime.Before(sorted[j].JoinTime)
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
@@ -0,0 +1,67 @@
+package daily
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/recording"
+	"github.com/lazeratops/daily-go/daily/room"
+	"time"
+)
+
+// StartRecording starts a recording of the given Daily room
+func (d *Daily) StartRecording(ctx context.Context, roomName string, opts *room.StartRecordingOpts) error {
+	return room.StartRecording(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, opts)
+}
+
+// StopRecording stops a recording of the given Daily room
+func (d *Daily) StopRecording(ctx context.Context, roomName string, opts *room.StopRecordingOpts) error {
+	return room.StopRecording(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, opts)
+}
+
+// UpdateRecording updates an ongoing recording of the given Daily room
+func (d *Daily) UpdateRecording(ctx context.Context, roomName string, opts room.UpdateRecordingOpts) error {
+	return room.UpdateRecording(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, opts)
+}
+
+// GetRecordings returns multiple Daily recordings matching
+// the given limits, if any
+func (d *Daily) GetRecordings(ctx context.Context, params *recording.GetManyParams) ([]recording.Recording, error) {
+	return recording.GetMany(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, params)
+}
+
+// GetRecording returns a single Daily recording matching the given ID
+func (d *Daily) GetRecording(ctx context.Context, recordingID string) (*recording.Recording, error) {
+	return recording.GetOne(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, recordingID)
+}
+
+// DeleteRecording deletes the given Daily recording
+func (d *Daily) DeleteRecording(ctx context.Context, recordingID string) error {
+	return recording.Delete(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, recordingID)
+}
+
+// GetRecordingAccessLink generates a download link for the given
+// Daily recording, valid for the given duration
+func (d *Daily) GetRecordingAccessLink(ctx context.Context, recordingID string, validFor time.Duration) (*recording.AccessLink, error) {
+	return recording.GetAccessLink(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, recordingID, validFor)
+}
@@ -0,0 +1,72 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// AccessLink is a temporary link to download a recording
+type AccessLink struct {
+	DownloadLink string `json:"download_link"`
+	// Expires is a Unix timestamp, see GetExpiry
+	Expires int64 `json:"expires"`
+}
+
+// GetExpiry retrieves the time after which the link stops working
+func (l *AccessLink) GetExpiry() time.Time {
+	return time.Unix(l.Expires, 0)
+}
+
+// GetAccessLink generates a download link for the recording with the
+// given ID. The link is valid for the given duration, or for Daily's
+// default duration if validFor is 0.
+func GetAccessLink(ctx context.Context, creds auth.Creds, recordingID string, validFor time.Duration) (*AccessLink, error) {
+	q := url.Values{}
+	if secs := int64(validFor / time.Second); secs > 0 {
+		q.Set("valid_for_secs", strconv.FormatInt(secs, 10))
+	}
+	endpoint, err := recordingsEndpointWithParams(creds.APIURL, q, recordingID, "access-link")
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request to access link endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recording access link: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+
+	var link AccessLink
+	if err := json.Unmarshal(resBody, &link); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &link, nil
+}
@@ -0,0 +1,59 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+)
+
+type deleteResponse struct {
+	ID string `json:"id"`
+}
+
+// Delete deletes the recording with the given ID
+func Delete(ctx context.Context, creds auth.Creds, recordingID string) error {
+	endpoint, err := recordingsEndpoint(creds.APIURL, recordingID)
+	if err != nil {
+		return err
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create DELETE request to recording endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete recording: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+
+	var dr deleteResponse
+	if err := json.Unmarshal(resBody, &dr); err != nil {
+		return NewErrFailUnmarshal(err)
+	}
+	if dr.ID != recordingID {
+		err := fmt.Errorf("requested deletion was of recording '%s', but recording reported deleted was '%s'", recordingID, dr.ID)
+		return NewErrFailRecordingDelete(err)
+	}
+	return nil
+}
@@ -0,0 +1,487 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"golang.org/x/sync/errgroup"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultDownloadConcurrency = 4
+	defaultDownloadChunkSize   = 16 << 20
+)
+
+// ProgressFunc is called as a download progresses with the number of
+// bytes downloaded so far and the total size of the download. The total
+// is -1 if the size of the download is not known.
+type ProgressFunc func(downloaded, total int64)
+
+// DownloadOpts represents optional parameters for downloading a recording
+type DownloadOpts struct {
+	// Concurrency is the maximum number of chunks
+	// downloaded at once. Defaults to 4.
+	Concurrency int
+	// ChunkSize is the size in bytes of each ranged
+	// request. Defaults to 16MiB.
+	ChunkSize int64
+	// OnProgress, if set, is called as bytes are downloaded.
+	// It is never called concurrently.
+	OnProgress ProgressFunc
+}
+
+var (
+	// errRangeMismatch is returned when a server answers a range
+	// request with a different range than the one requested
+	errRangeMismatch = errors.New("server returned a different range than requested")
+	// errFileChanged is returned when the file on the
+	// server changes while it is being downloaded
+	errFileChanged = errors.New("file changed on the server during download")
+)
+
+// probe is what is known about a file before downloading it
+type probe struct {
+	// total is the size of the file, or -1 if not known
+	total           int64
+	rangesSupported bool
+	lastModified    time.Time
+	etag            string
+}
+
+// validator returns a value identifying the version of the file, for
+// use in an If-Range header, or "" if the server did not provide one.
+// Weak ETags can't be used with If-Range, so Last-Modified is used instead.
+func (p probe) validator() string {
+	if p.etag != "" && !strings.HasPrefix(p.etag, "W/") {
+		return p.etag
+	}
+	if !p.lastModified.IsZero() {
+		return p.lastModified.UTC().Format(http.TimeFormat)
+	}
+	return ""
+}
+
+// partsManifest describes the part files of a ranged download. It is
+// stored next to them, so they are only resumed by a download of the
+// same version of the file split into the same chunks.
+type partsManifest struct {
+	ChunkSize int64  `json:"chunk_size"`
+	Total     int64  `json:"total"`
+	Validator string `json:"validator"`
+}
+
+// chunk is an inclusive byte range of the file being downloaded
+type chunk struct {
+	start int64
+	end   int64
+	path  string
+}
+
+func (c chunk) size() int64 {
+	return c.end - c.start + 1
+}
+
+// Download downloads the file at the given recording access link to dst.
+// If the server supports range requests, the file is fetched in parallel
+// chunks which are stored next to dst until the download completes, so an
+// interrupted download resumes where it left off when retried. Chunks are
+// only resumed if the server identifies the file with an ETag or
+// Last-Modified date, and the file has not changed since they were
+// downloaded. If the server answers with a different range than requested,
+// the whole file is downloaded instead. The size of the downloaded file is
+// verified against the size reported by the server.
+//
+// dst is only written once the download completes, so an existing dst
+// is treated as complete if it has the expected size and was not
+// written before the file was last modified on the server. Without a
+// Last-Modified date, the file is always downloaded again.
+func Download(ctx context.Context, link string, dst string, opts *DownloadOpts) error {
+	o := DownloadOpts{
+		Concurrency: defaultDownloadConcurrency,
+		ChunkSize:   defaultDownloadChunkSize,
+	}
+	if opts != nil {
+		if opts.Concurrency > 0 {
+			o.Concurrency = opts.Concurrency
+		}
+		if opts.ChunkSize > 0 {
+			o.ChunkSize = opts.ChunkSize
+		}
+		o.OnProgress = opts.OnProgress
+	}
+
+	p, err := probeDownload(ctx, link)
+	if err != nil {
+		return err
+	}
+	total := p.total
+	progress := &progressTracker{total: total, onProgress: o.OnProgress}
+
+	// Nothing to do if a previous run already completed this download
+	if total >= 0 && !p.lastModified.IsZero() {
+		if fi, err := os.Stat(dst); err == nil && fi.Size() == total && !fi.ModTime().Before(p.lastModified) {
+			progress.add(total)
+			return nil
+		}
+	}
+
+	if !p.rangesSupported {
+		return downloadWholeFile(ctx, link, dst, total, progress)
+	}
+
+	chunks := splitChunks(dst, total, o.ChunkSize)
+	validator := p.validator()
+	if err := prepareParts(dst, chunks, partsManifest{
+		ChunkSize: o.ChunkSize,
+		Total:     total,
+		Validator: validator,
+	}); err != nil {
+		return err
+	}
+
+	errs, chunkCtx := errgroup.WithContext(ctx)
+	errs.SetLimit(o.Concurrency)
+	for _, c := range chunks {
+		c := c
+		errs.Go(func() error {
+			return downloadChunk(chunkCtx, link, c, validator, progress)
+		})
+	}
+	if err := errs.Wait(); err != nil {
+		if !errors.Is(err, errRangeMismatch) && !errors.Is(err, errFileChanged) {
+			return err
+		}
+		// The chunks can't be trusted, so discard them
+		if err := removeParts(dst, chunks); err != nil {
+			return err
+		}
+		if errors.Is(err, errFileChanged) {
+			return NewErrFailedDownload(err)
+		}
+		// The server's ranges can't be trusted, so start over in one go
+		return downloadWholeFile(ctx, link, dst, total, &progressTracker{total: total, onProgress: o.OnProgress})
+	}
+	return assembleChunks(dst, chunks, total)
+}
+
+// manifestPath returns the path of the parts manifest of the given dst
+func manifestPath(dst string) string {
+	return dst + ".parts.json"
+}
+
+// prepareParts discards existing part files of dst unless their manifest
+// matches the given one, and then writes the given manifest. Part files
+// are always discarded if the file has no validator, since there is no
+// way to tell whether they belong to the current version of the file.
+func prepareParts(dst string, chunks []chunk, want partsManifest) error {
+	var have partsManifest
+	data, err := os.ReadFile(manifestPath(dst))
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &have); err != nil {
+			// An unreadable manifest is as good as none
+			have = partsManifest{}
+		}
+	case !os.IsNotExist(err):
+		return fmt.Errorf("failed to read parts manifest: %w", err)
+	}
+
+	if want.Validator == "" || have != want {
+		// Parts of a previous download may have been split differently
+		stale := chunks
+		if have.ChunkSize > 0 && have.Total > 0 {
+			stale = append(stale, splitChunks(dst, have.Total, have.ChunkSize)...)
+		}
+		if err := removeParts(dst, stale); err != nil {
+			return err
+		}
+	}
+
+	data, err = json.Marshal(want)
+	if err != nil {
+		return fmt.Errorf("failed to marshal parts manifest: %w", err)
+	}
+	if err := os.WriteFile(manifestPath(dst), data, 0o644); err != nil {
+		return fmt.Errorf("failed to write parts manifest: %w", err)
+	}
+	return nil
+}
+
+// removeParts removes the given part files of dst and their manifest
+func removeParts(dst string, chunks []chunk) error {
+	for _, c := range chunks {
+		if err := os.Remove(c.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove part file: %w", err)
+		}
+	}
+	if err := os.Remove(manifestPath(dst)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove parts manifest: %w", err)
+	}
+	return nil
+}
+
+// downloadWholeFile downloads the entire file to dst
+// without range requests
+func downloadWholeFile(ctx context.Context, link string, dst string, total int64, progress *progressTracker) error {
+	c := chunk{start: 0, end: total - 1, path: dst + ".part0"}
+	if err := downloadWhole(ctx, link, c, progress); err != nil {
+		return err
+	}
+	return assembleChunks(dst, []chunk{c}, total)
+}
+
+// probeDownload requests the first byte of the given link to find the
+// total size of the file, whether range requests are supported and
+// which version of the file is being downloaded.
+func probeDownload(ctx context.Context, link string) (probe, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", link, nil)
+	if err != nil {
+		return probe{}, fmt.Errorf("failed to create GET request to download link: %w", err)
+	}
+	req.Header.Set("Range", "bytes=0-0")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return probe{}, fmt.Errorf("failed to probe download link: %w", err)
+	}
+	defer res.Body.Close()
+
+	p := probe{etag: res.Header.Get("ETag")}
+	if lm := res.Header.Get("Last-Modified"); lm != "" {
+		// An unparseable date is as good as none
+		p.lastModified, _ = http.ParseTime(lm)
+	}
+
+	switch res.StatusCode {
+	case http.StatusPartialContent:
+		_, _, total, err := parseContentRange(res.Header.Get("Content-Range"))
+		if err != nil {
+			return probe{}, err
+		}
+		p.total = total
+		p.rangesSupported = true
+		return p, nil
+	case http.StatusOK:
+		p.total = res.ContentLength
+		return p, nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The file is empty, so even the first byte is out of range
+		return p, nil
+	default:
+		resBody, _ := io.ReadAll(res.Body)
+		return probe{}, NewErrFailedDownload(fmt.Errorf("status code: %d; body: %s", res.StatusCode, string(resBody)))
+	}
+}
+
+// parseContentRange returns the inclusive range and total size
+// from a Content-Range header such as "bytes 0-0/1234".
+func parseContentRange(contentRange string) (int64, int64, int64, error) {
+	invalid := func(err error) error {
+		return NewErrFailedDownload(fmt.Errorf("invalid Content-Range header '%s': %w", contentRange, err))
+	}
+
+	var start, end, total int64
+	if _, err := fmt.Sscanf(contentRange, "bytes %d-%d/%d", &start, &end, &total); err != nil {
+		return 0, 0, 0, invalid(err)
+	}
+	if start < 0 || end < start || total <= end {
+		return 0, 0, 0, invalid(errors.New("range is out of bounds"))
+	}
+	return start, end, total, nil
+}
+
+func splitChunks(dst string, total int64, chunkSize int64) []chunk {
+	var chunks []chunk
+	for start := int64(0); start < total; start += chunkSize {
+		end := start + chunkSize - 1
+		if end >= total {
+			end = total - 1
+		}
+		chunks = append(chunks, chunk{
+			start: start,
+			end:   end,
+			path:  fmt.Sprintf("%s.part%d", dst, len(chunks)),
+		})
+	}
+	return chunks
+}
+
+// downloadChunk downloads the given byte range to the chunk's part file,
+// continuing from whatever the part file already holds. If a validator
+// is given, the range is only served if the file has not changed since.
+func downloadChunk(ctx context.Context, link string, c chunk, validator string, progress *progressTracker) error {
+	var have int64
+	if fi, err := os.Stat(c.path); err == nil {
+		have = fi.Size()
+	}
+	if have > c.size() {
+		// The part file is not one we can trust, start over
+		have = 0
+		if err := os.Remove(c.path); err != nil {
+			return fmt.Errorf("failed to remove invalid part file: %w", err)
+		}
+	}
+	progress.add(have)
+	if have == c.size() {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", link, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create GET request to download link: %w", err)
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", c.start+have, c.end))
+	if validator != "" {
+		req.Header.Set("If-Range", validator)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download chunk: %w", err)
+	}
+	defer res.Body.Close()
+
+	// The whole file is sent instead if it no longer matches the validator
+	if validator != "" && res.StatusCode == http.StatusOK {
+		return errFileChanged
+	}
+	if res.StatusCode != http.StatusPartialContent {
+		resBody, _ := io.ReadAll(res.Body)
+		return NewErrFailedDownload(fmt.Errorf("status code: %d; body: %s", res.StatusCode, string(resBody)))
+	}
+	// Appending any other range would corrupt the part file
+	start, end, _, err := parseContentRange(res.Header.Get("Content-Range"))
+	if err != nil {
+		return err
+	}
+	if start != c.start+have || end != c.end {
+		return fmt.Errorf("requested bytes %d-%d, got %d-%d: %w", c.start+have, c.end, start, end, errRangeMismatch)
+	}
+
+	f, err := os.OpenFile(c.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open part file: %w", err)
+	}
+	defer f.Close()
+
+	n, err := io.Copy(f, io.TeeReader(res.Body, progress))
+	if err != nil {
+		return fmt.Errorf("failed to write part file: %w", err)
+	}
+	if have+n != c.size() {
+		return NewErrSizeMismatch(c.size(), have+n)
+	}
+	return nil
+}
+
+// downloadWhole downloads the entire file to the chunk's part
+// file, for servers which do not support range requests.
+func downloadWhole(ctx context.Context, link string, c chunk, progress *progressTracker) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", link, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create GET request to download link: %w", err)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		resBody, _ := io.ReadAll(res.Body)
+		return NewErrFailedDownload(fmt.Errorf("status code: %d; body: %s", res.StatusCode, string(resBody)))
+	}
+
+	f, err := os.Create(c.path)
+	if err != nil {
+		return fmt.Errorf("failed to create part file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, io.TeeReader(res.Body, progress)); err != nil {
+		return fmt.Errorf("failed to write part file: %w", err)
+	}
+	return nil
+}
+
+// assembleChunks concatenates the given chunks into dst, verifies the
+// resulting size and removes the part files and their manifest. The
+// chunks are assembled into a temporary file which is renamed to dst
+// once complete, so dst never holds a partial download.
+func assembleChunks(dst string, chunks []chunk, total int64) error {
+	tmp := dst + ".tmp"
+	if err := writeChunks(tmp, chunks, total); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		return fmt.Errorf("failed to move download into place: %w", err)
+	}
+
+	return removeParts(dst, chunks)
+}
+
+// writeChunks concatenates the given chunks into
+// the given file and verifies the resulting size
+func writeChunks(path string, chunks []chunk, total int64) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create destination file: %w", err)
+	}
+	defer f.Close()
+
+	var written int64
+	for _, c := range chunks {
+		part, err := os.Open(c.path)
+		if err != nil {
+			return fmt.Errorf("failed to open part file: %w", err)
+		}
+		n, err := io.Copy(f, part)
+		part.Close()
+		if err != nil {
+			return fmt.Errorf("failed to write destination file: %w", err)
+		}
+		written += n
+	}
+	if total >= 0 && written != total {
+		return NewErrSizeMismatch(total, written)
+	}
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("failed to sync destination file: %w", err)
+	}
+	return nil
+}
+
+// progressTracker counts downloaded bytes across
+// chunks and reports them to the progress callback.
+type progressTracker struct {
+	mu         sync.Mutex
+	downloaded int64
+	total      int64
+	onProgress ProgressFunc
+}
+
+func (p *progressTracker) Write(b []byte) (int, error) {
+	p.add(int64(len(b)))
+	return len(b), nil
+}
+
+func (p *progressTracker) add(n int64) {
+	if n == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.downloaded += n
+	if p.onProgress != nil {
+		p.onProgress(p.downloaded, p.total)
+	}
+}
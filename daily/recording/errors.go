@@ -0,0 +1,31 @@
+package recording
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrFailUnmarshal       = errors.New("failed to unmarshal response body into Recording")
+	ErrFailRecordingDelete = errors.New("failed to delete recording")
+	ErrFailedDownload      = errors.New("failed to download recording")
+	// ErrSizeMismatch is returned when a downloaded recording
+	// is not the size reported by the server.
+	ErrSizeMismatch = errors.New("downloaded size does not match expected size")
+)
+
+func NewErrFailUnmarshal(unmarshalErr error) error {
+	return fmt.Errorf("%s: %w", unmarshalErr, ErrFailUnmarshal)
+}
+
+func NewErrFailRecordingDelete(deleteErr error) error {
+	return fmt.Errorf("%s: %w", deleteErr, ErrFailRecordingDelete)
+}
+
+func NewErrFailedDownload(err error) error {
+	return fmt.Errorf("%s: %w", err, ErrFailedDownload)
+}
+
+func NewErrSizeMismatch(want, got int64) error {
+	return fmt.Errorf("expected %d bytes, got %d bytes: %w", want, got, ErrSizeMismatch)
+}
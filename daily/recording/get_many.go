@@ -0,0 +1,82 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/pagination"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type GetManyParams struct {
+	// Limit is the maximum number of recordings to
+	// retrieve. If 0, all recordings are retrieved.
+	Limit         int
+	StartingAfter string
+	// RoomName only retrieves recordings of the given room
+	RoomName string
+}
+
+// GetMany returns recordings matching the given params, if any.
+// If no params are given, all recordings are returned.
+func GetMany(ctx context.Context, creds auth.Creds, params *GetManyParams) ([]Recording, error) {
+	if params == nil {
+		params = &GetManyParams{}
+	}
+	if err := pagination.ValidateLimit(params.Limit); err != nil {
+		return nil, err
+	}
+	return pagination.Collect(params.Limit, params.StartingAfter, func(cursor string, limit int) (*pagination.Page[Recording], error) {
+		return doGetRecordings(ctx, creds, params.RoomName, cursor, limit)
+	}, func(r Recording) string {
+		return r.ID
+	})
+}
+
+func doGetRecordings(ctx context.Context, creds auth.Creds, roomName string, cursor string, limit int) (*pagination.Page[Recording], error) {
+	q := url.Values{}
+	pagination.SetQueryParams(q, cursor, limit)
+	if roomName != "" {
+		q.Set("room_name", roomName)
+	}
+	endpoint, err := recordingsEndpointWithParams(creds.APIURL, q)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request to recordings endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recordings: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+
+	var page pagination.Page[Recording]
+	if err := json.Unmarshal(resBody, &page); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &page, nil
+}
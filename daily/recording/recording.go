@@ -0,0 +1,91 @@
+// Package recording handles Daily recordings
+package recording
+
+import (
+	"github.com/lazeratops/daily-go/daily/errors"
+	"net/url"
+	"path"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusInProgress Status = "in-progress"
+	StatusFinished   Status = "finished"
+	StatusCanceled   Status = "canceled"
+)
+
+// Track is a single media track of a raw-tracks recording
+type Track struct {
+	Type  string `json:"type"`
+	S3Key string `json:"s3Key"`
+	Size  int64  `json:"size"`
+}
+
+// Recording represents a Daily recording
+type Recording struct {
+	ID       string `json:"id"`
+	RoomName string `json:"room_name"`
+	// StartTS is a Unix timestamp, see GetStartTime
+	StartTS int64 `json:"start_ts"`
+	// Duration is in seconds, see GetDuration
+	Duration        int     `json:"duration"`
+	Status          Status  `json:"status"`
+	MaxParticipants int     `json:"max_participants"`
+	ShareToken      string  `json:"share_token"`
+	S3Key           string  `json:"s3key"`
+	MtgSessionID    string  `json:"mtgSessionId"`
+	Tracks          []Track `json:"tracks"`
+}
+
+// GetStartTime retrieves the time the recording started
+func (r *Recording) GetStartTime() time.Time {
+	return time.Unix(r.StartTS, 0)
+}
+
+// GetDuration retrieves the length of the recording
+func (r *Recording) GetDuration() time.Duration {
+	return time.Duration(r.Duration) * time.Second
+}
+
+// GetFileExtension returns the file extension of the recording's media.
+// Cloud recordings are MP4 files, while raw-tracks recordings are made
+// of WebM tracks. An extension in the recording's S3 key takes precedence.
+func (r *Recording) GetFileExtension() string {
+	if ext := path.Ext(r.S3Key); ext != "" {
+		return ext
+	}
+	if len(r.Tracks) > 0 {
+		return ".webm"
+	}
+	return ".mp4"
+}
+
+func recordingsEndpointWithParams(apiURL string, query url.Values, paths ...string) (string, error) {
+	u, err := recordingsURL(apiURL, paths...)
+	if err != nil {
+		return "", err
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+func recordingsEndpoint(apiURL string, paths ...string) (string, error) {
+	u, err := recordingsURL(apiURL, paths...)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func recordingsURL(apiURL string, paths ...string) (*url.URL, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, errors.NewErrFailedEndpointConstruction(err)
+	}
+
+	allPaths := append([]string{u.Path, "recordings"}, paths...)
+	u.Path = path.Join(allPaths...)
+	return u, nil
+}
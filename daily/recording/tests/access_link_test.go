@@ -0,0 +1,78 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/recording"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetAccessLink(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name               string
+		validFor           time.Duration
+		wantValidForSecs   string
+		dailyResStatusCode int
+		dailyResBody       string
+		wantLink           recording.AccessLink
+		wantErr            error
+	}{
+		{
+			name:               "default validity",
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody:       `{"download_link":"https://example.com/rec.mp4","expires":1669044140}`,
+			wantLink: recording.AccessLink{
+				DownloadLink: "https://example.com/rec.mp4",
+				Expires:      1669044140,
+			},
+		},
+		{
+			name:               "custom validity",
+			validFor:           2 * time.Hour,
+			wantValidForSecs:   "7200",
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody:       `{"download_link":"https://example.com/rec.mp4","expires":1669047740}`,
+			wantLink: recording.AccessLink{
+				DownloadLink: "https://example.com/rec.mp4",
+				Expires:      1669047740,
+			},
+		},
+		{
+			name:               "bad status code",
+			dailyResStatusCode: http.StatusNotFound,
+			wantErr:            errors.ErrFailedAPICall,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/recordings/some-id/access-link", r.URL.Path)
+				require.Equal(t, tc.wantValidForSecs, r.URL.Query().Get("valid_for_secs"))
+				w.WriteHeader(tc.dailyResStatusCode)
+				_, err := w.Write([]byte(tc.dailyResBody))
+				require.NoError(t, err)
+			}))
+
+			defer testServer.Close()
+
+			gotLink, gotErr := recording.GetAccessLink(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-id", tc.validFor)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			if gotErr == nil {
+				require.Equal(t, tc.wantLink, *gotLink)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/recording"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name               string
+		recordingID        string
+		dailyResStatusCode int
+		dailyResBody       string
+		wantErr            error
+	}{
+		{
+			name:               "success",
+			recordingID:        "b6d1c1a4-2d4e-4e7b-9b61-2a3c1a7f3e2d",
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody: `
+			{
+			  "id": "b6d1c1a4-2d4e-4e7b-9b61-2a3c1a7f3e2d",
+			  "room_name": "w2pp2cf4kltgFACPKXmX"
+			}`,
+		},
+		{
+			name:               "wrong-recording-id",
+			recordingID:        "b6d1c1a4-2d4e-4e7b-9b61-2a3c1a7f3e2d",
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody: `
+			{
+			  "id": "0f5e1a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+			}`,
+			wantErr: recording.ErrFailRecordingDelete,
+		},
+		{
+			name:               "bad status code",
+			recordingID:        "b6d1c1a4-2d4e-4e7b-9b61-2a3c1a7f3e2d",
+			dailyResStatusCode: http.StatusNotFound,
+			wantErr:            errors.ErrFailedAPICall,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "DELETE", r.Method)
+				require.Equal(t, "/recordings/"+tc.recordingID, r.URL.Path)
+				w.WriteHeader(tc.dailyResStatusCode)
+				_, err := w.Write([]byte(tc.dailyResBody))
+				require.NoError(t, err)
+			}))
+
+			defer testServer.Close()
+
+			gotErr := recording.Delete(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, tc.recordingID)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+		})
+	}
+}
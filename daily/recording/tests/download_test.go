@@ -0,0 +1,277 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/recording"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// manifest returns the parts manifest of a download of the
+// test content, as Download stores it next to the part files
+func manifest(chunkSize int, etag string) []byte {
+	return []byte(fmt.Sprintf(`{"chunk_size":%d,"total":1000,"validator":%q}`, chunkSize, etag))
+}
+
+func TestDownload(t *testing.T) {
+	t.Parallel()
+	content := make([]byte, 1000)
+	_, err := rand.Read(content)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		// serveRanges controls whether the server honors Range headers
+		serveRanges bool
+		// truncateBy makes the server send fewer bytes than it reports
+		truncateBy int
+		// misalignRanges makes the server answer every chunk
+		// request with the first chunk's range
+		misalignRanges bool
+		// existingParts are part files present before the download
+		existingParts map[string][]byte
+		wantErr       error
+		wantRanges    []string
+	}{
+		{
+			name:        "ranged download",
+			serveRanges: true,
+			wantRanges:  []string{"bytes=0-0", "bytes=0-299", "bytes=300-599", "bytes=600-899", "bytes=900-999"},
+		},
+		{
+			name:        "resume",
+			serveRanges: true,
+			existingParts: map[string][]byte{
+				".part0":      content[:300],
+				".part1":      content[300:350],
+				".parts.json": manifest(300, `"v1"`),
+			},
+			wantRanges: []string{"bytes=0-0", "bytes=350-599", "bytes=600-899", "bytes=900-999"},
+		},
+		{
+			name:        "parts without manifest are discarded",
+			serveRanges: true,
+			existingParts: map[string][]byte{
+				".part0": content[:300],
+				".part1": content[300:350],
+			},
+			wantRanges: []string{"bytes=0-0", "bytes=0-299", "bytes=300-599", "bytes=600-899", "bytes=900-999"},
+		},
+		{
+			name:        "parts of a different chunk size are discarded",
+			serveRanges: true,
+			existingParts: map[string][]byte{
+				".part0":      content[:250],
+				".part1":      content[250:400],
+				".part3":      content[750:800],
+				".parts.json": manifest(250, `"v1"`),
+			},
+			wantRanges: []string{"bytes=0-0", "bytes=0-299", "bytes=300-599", "bytes=600-899", "bytes=900-999"},
+		},
+		{
+			name:        "parts of a changed file are discarded",
+			serveRanges: true,
+			existingParts: map[string][]byte{
+				".part0":      content[:300],
+				".part1":      content[300:350],
+				".parts.json": manifest(300, `"v0"`),
+			},
+			wantRanges: []string{"bytes=0-0", "bytes=0-299", "bytes=300-599", "bytes=600-899", "bytes=900-999"},
+		},
+		{
+			name:        "ranges unsupported",
+			serveRanges: false,
+			wantRanges:  []string{"bytes=0-0", ""},
+		},
+		{
+			name:           "misaligned ranges fall back to whole download",
+			serveRanges:    true,
+			misalignRanges: true,
+		},
+		{
+			name:        "short body",
+			serveRanges: true,
+			truncateBy:  10,
+			wantErr:     recording.ErrSizeMismatch,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var gotRanges []string
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				gotRanges = append(gotRanges, r.Header.Get("Range"))
+				mu.Unlock()
+				if tc.serveRanges {
+					if tc.truncateBy > 0 && r.Header.Get("Range") == "bytes=0-0" {
+						// Report the full size, but serve truncated content afterwards
+						w.Header().Set("Content-Range", fmt.Sprintf("bytes 0-0/%d", len(content)))
+						w.WriteHeader(http.StatusPartialContent)
+						_, err := w.Write(content[:1])
+						require.NoError(t, err)
+						return
+					}
+					if tc.misalignRanges && strings.HasPrefix(r.Header.Get("Range"), "bytes=3") {
+						w.Header().Set("Content-Range", fmt.Sprintf("bytes 0-299/%d", len(content)))
+						w.WriteHeader(http.StatusPartialContent)
+						_, err := w.Write(content[:300])
+						require.NoError(t, err)
+						return
+					}
+					w.Header().Set("ETag", `"v1"`)
+					http.ServeContent(w, r, "rec.mp4", time.Time{}, bytes.NewReader(content[:len(content)-tc.truncateBy]))
+					return
+				}
+				_, err := w.Write(content)
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			dst := filepath.Join(t.TempDir(), "rec.mp4")
+			for suffix, data := range tc.existingParts {
+				require.NoError(t, os.WriteFile(dst+suffix, data, 0o644))
+			}
+
+			var lastDownloaded, lastTotal int64
+			gotErr := recording.Download(context.Background(), testServer.URL, dst, &recording.DownloadOpts{
+				Concurrency: 2,
+				ChunkSize:   300,
+				OnProgress: func(downloaded, total int64) {
+					require.GreaterOrEqual(t, downloaded, lastDownloaded)
+					lastDownloaded, lastTotal = downloaded, total
+				},
+			})
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			if tc.wantErr != nil {
+				return
+			}
+
+			gotContent, err := os.ReadFile(dst)
+			require.NoError(t, err)
+			require.Equal(t, content, gotContent)
+			require.EqualValues(t, len(content), lastTotal)
+			if tc.misalignRanges {
+				// The whole file is requested once chunks are found to be misaligned
+				require.Contains(t, gotRanges, "")
+			} else {
+				require.EqualValues(t, len(content), lastDownloaded)
+				require.ElementsMatch(t, tc.wantRanges, gotRanges)
+			}
+
+			// Part files are cleaned up once the download completes
+			matches, err := filepath.Glob(dst + ".part*")
+			require.NoError(t, err)
+			require.Empty(t, matches)
+		})
+	}
+}
+
+func TestDownloadAlreadyComplete(t *testing.T) {
+	t.Parallel()
+	content := []byte(strings.Repeat("a", 100))
+	modified := time.Now().Add(-time.Hour)
+	var calls int
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.ServeContent(w, r, "rec.mp4", modified, bytes.NewReader(content))
+	}))
+	defer testServer.Close()
+
+	dst := filepath.Join(t.TempDir(), "rec.mp4")
+	require.NoError(t, os.WriteFile(dst, content, 0o644))
+
+	require.NoError(t, recording.Download(context.Background(), testServer.URL, dst, nil))
+	// Only the probe request is made
+	require.Equal(t, 1, calls)
+}
+
+func TestDownloadStaleFile(t *testing.T) {
+	t.Parallel()
+	content := []byte(strings.Repeat("a", 100))
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "rec.mp4", time.Now(), bytes.NewReader(content))
+	}))
+	defer testServer.Close()
+
+	// A file of the right size written before the
+	// recording was last modified is not trusted
+	dst := filepath.Join(t.TempDir(), "rec.mp4")
+	require.NoError(t, os.WriteFile(dst, []byte(strings.Repeat("b", 100)), 0o644))
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(dst, old, old))
+
+	require.NoError(t, recording.Download(context.Background(), testServer.URL, dst, nil))
+	gotContent, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	require.Equal(t, content, gotContent)
+}
+
+func TestDownloadWithoutLastModified(t *testing.T) {
+	t.Parallel()
+	content := []byte(strings.Repeat("a", 100))
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "rec.mp4", time.Time{}, bytes.NewReader(content))
+	}))
+	defer testServer.Close()
+
+	// Without a Last-Modified date, there is no telling
+	// whether a file of the right size is up to date
+	dst := filepath.Join(t.TempDir(), "rec.mp4")
+	require.NoError(t, os.WriteFile(dst, []byte(strings.Repeat("b", 100)), 0o644))
+
+	require.NoError(t, recording.Download(context.Background(), testServer.URL, dst, nil))
+	gotContent, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	require.Equal(t, content, gotContent)
+}
+
+func TestDownloadFileChanged(t *testing.T) {
+	t.Parallel()
+	content := []byte(strings.Repeat("a", 1000))
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The file changes right after it is probed
+		etag := `"v2"`
+		if r.Header.Get("Range") == "bytes=0-0" {
+			etag = `"v1"`
+		}
+		w.Header().Set("ETag", etag)
+		http.ServeContent(w, r, "rec.mp4", time.Time{}, bytes.NewReader(content))
+	}))
+	defer testServer.Close()
+
+	dst := filepath.Join(t.TempDir(), "rec.mp4")
+	err := recording.Download(context.Background(), testServer.URL, dst, &recording.DownloadOpts{
+		ChunkSize: 300,
+	})
+	require.ErrorIs(t, err, recording.ErrFailedDownload)
+
+	// Nothing of the changed file is kept
+	matches, err := filepath.Glob(dst + "*")
+	require.NoError(t, err)
+	require.Empty(t, matches)
+}
+
+func TestGetFileExtension(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, ".mp4", (&recording.Recording{S3Key: "api-demo/room/1669040540270"}).GetFileExtension())
+	require.Equal(t, ".webm", (&recording.Recording{
+		S3Key:  "api-demo/room/1669040540270",
+		Tracks: []recording.Track{{Type: "video", S3Key: "api-demo/room/1669040540270-cam-video.webm"}},
+	}).GetFileExtension())
+	require.Equal(t, ".mkv", (&recording.Recording{S3Key: "api-demo/room/1669040540270.mkv"}).GetFileExtension())
+}
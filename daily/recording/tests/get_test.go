@@ -0,0 +1,198 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/recording"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetOne(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name               string
+		dailyResStatusCode int
+		dailyResBody       string
+		wantRecording      recording.Recording
+		wantErr            error
+	}{
+		{
+			name:               "bad status code",
+			dailyResStatusCode: http.StatusNotFound,
+			dailyResBody:       "{}",
+			wantErr:            errors.ErrFailedAPICall,
+		},
+		{
+			name:               "recording retrieved",
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody: `
+				{
+					"id": "b6d1c1a4-2d4e-4e7b-9b61-2a3c1a7f3e2d",
+					"room_name": "w2pp2cf4kltgFACPKXmX",
+					"start_ts": 1669040540,
+					"status": "finished",
+					"max_participants": 2,
+					"duration": 284,
+					"share_token": "ZAfnAdxwq2jh",
+					"s3key": "api-demo/w2pp2cf4kltgFACPKXmX/1669040540270",
+					"mtgSessionId": "4b2e9c1e-0d5b-4f3a-8c7e-8a3b2f1e6d7c",
+					"tracks": [
+						{"type": "video", "s3Key": "api-demo/w2pp2cf4kltgFACPKXmX/1669040540270-cam-video", "size": 1024}
+					]
+				}
+			`,
+			wantRecording: recording.Recording{
+				ID:              "b6d1c1a4-2d4e-4e7b-9b61-2a3c1a7f3e2d",
+				RoomName:        "w2pp2cf4kltgFACPKXmX",
+				StartTS:         1669040540,
+				Status:          recording.StatusFinished,
+				MaxParticipants: 2,
+				Duration:        284,
+				ShareToken:      "ZAfnAdxwq2jh",
+				S3Key:           "api-demo/w2pp2cf4kltgFACPKXmX/1669040540270",
+				MtgSessionID:    "4b2e9c1e-0d5b-4f3a-8c7e-8a3b2f1e6d7c",
+				Tracks: []recording.Track{
+					{
+						Type:  "video",
+						S3Key: "api-demo/w2pp2cf4kltgFACPKXmX/1669040540270-cam-video",
+						Size:  1024,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/recordings/some-id", r.URL.Path)
+				w.WriteHeader(tc.dailyResStatusCode)
+				_, err := w.Write([]byte(tc.dailyResBody))
+				require.NoError(t, err)
+			}))
+
+			defer testServer.Close()
+
+			gotRecording, gotErr := recording.GetOne(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-id")
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			if gotErr == nil {
+				require.EqualValues(t, tc.wantRecording, *gotRecording)
+				require.Equal(t, 284*time.Second, gotRecording.GetDuration())
+			}
+		})
+	}
+}
+
+func TestGetMany(t *testing.T) {
+	t.Parallel()
+	const totalRecordings = 250
+	testCases := []struct {
+		name      string
+		params    *recording.GetManyParams
+		wantIDs   []string
+		wantCalls int
+	}{
+		{
+			name:      "all",
+			wantIDs:   recordingIDs(0, totalRecordings),
+			wantCalls: 3,
+		},
+		{
+			name: "limited",
+			params: &recording.GetManyParams{
+				Limit: 120,
+			},
+			wantIDs:   recordingIDs(0, 120),
+			wantCalls: 2,
+		},
+		{
+			name: "starting after",
+			params: &recording.GetManyParams{
+				Limit:         5,
+				StartingAfter: "rec-9",
+			},
+			wantIDs:   recordingIDs(10, 15),
+			wantCalls: 1,
+		},
+		{
+			name: "room filter",
+			params: &recording.GetManyParams{
+				RoomName: "some-room",
+			},
+			wantIDs:   recordingIDs(0, totalRecordings),
+			wantCalls: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotCalls int
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotCalls++
+				q := r.URL.Query()
+				if tc.params != nil {
+					require.Equal(t, tc.params.RoomName, q.Get("room_name"))
+				}
+
+				// Serve a page of fake recordings after the given cursor
+				start := 0
+				if after := q.Get("starting_after"); after != "" {
+					n, err := strconv.Atoi(strings.TrimPrefix(after, "rec-"))
+					require.NoError(t, err)
+					start = n + 1
+				}
+				limit, err := strconv.Atoi(q.Get("limit"))
+				require.NoError(t, err)
+				end := start + limit
+				if end > totalRecordings {
+					end = totalRecordings
+				}
+				var data []string
+				for _, id := range recordingIDs(start, end) {
+					data = append(data, fmt.Sprintf(`{"id":"%s"}`, id))
+				}
+				_, err = fmt.Fprintf(w, `{"total_count":%d,"data":[%s]}`, totalRecordings, strings.Join(data, ","))
+				require.NoError(t, err)
+			}))
+
+			defer testServer.Close()
+
+			gotRecordings, gotErr := recording.GetMany(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, tc.params)
+			require.NoError(t, gotErr)
+			var gotIDs []string
+			for _, r := range gotRecordings {
+				gotIDs = append(gotIDs, r.ID)
+			}
+			require.Equal(t, tc.wantIDs, gotIDs)
+			require.Equal(t, tc.wantCalls, gotCalls)
+		})
+	}
+}
+
+func recordingIDs(from, to int) []string {
+	var ids []string
+	for i := from; i < to; i++ {
+		ids = append(ids, fmt.Sprintf("rec-%d", i))
+	}
+	return ids
+}
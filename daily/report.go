@@ -0,0 +1,22 @@
+package daily
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/meeting"
+	"github.com/lazeratops/daily-go/daily/report"
+	"time"
+)
+
+// GetUsageReport aggregates the usage of Daily meetings
+// which started between the given times
+func (d *Daily) GetUsageReport(ctx context.Context, from, to time.Time, opts report.UsageOpts) ([]report.Usage, error) {
+	meetings, err := d.GetMeetings(ctx, &meeting.GetManyParams{
+		TimeframeStart: from,
+		TimeframeEnd:   to,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get meetings for usage report: %w", err)
+	}
+	return report.AggregateUsage(meetings, opts)
+}
@@ -0,0 +1,70 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// jsonUsage is the JSON representation of usage,
+// with the average duration in seconds
+type jsonUsage struct {
+	Group                  string  `json:"group"`
+	Meetings               int     `json:"meetings"`
+	ParticipantMinutes     float64 `json:"participant_minutes"`
+	PeakConcurrency        int     `json:"peak_concurrency"`
+	AverageDurationSeconds float64 `json:"average_duration_seconds"`
+}
+
+// UsageHeader is the column header for tabular usage output
+func UsageHeader() []string {
+	return []string{"Group", "Meetings", "Participant minutes", "Peak concurrency", "Average duration"}
+}
+
+// UsageRow formats the given usage as a row matching UsageHeader
+func UsageRow(u Usage) []string {
+	return []string{
+		u.Group,
+		strconv.Itoa(u.Meetings),
+		strconv.FormatFloat(u.ParticipantMinutes, 'f', 1, 64),
+		strconv.Itoa(u.PeakConcurrency),
+		u.AverageDuration.String(),
+	}
+}
+
+// WriteCSV writes the given usage as CSV with a header row
+func WriteCSV(w io.Writer, usages []Usage) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(UsageHeader()); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, u := range usages {
+		if err := cw.Write(UsageRow(u)); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the given usage as a JSON array
+func WriteJSON(w io.Writer, usages []Usage) error {
+	out := make([]jsonUsage, 0, len(usages))
+	for _, u := range usages {
+		out = append(out, jsonUsage{
+			Group:                  u.Group,
+			Meetings:               u.Meetings,
+			ParticipantMinutes:     u.ParticipantMinutes,
+			PeakConcurrency:        u.PeakConcurrency,
+			AverageDurationSeconds: u.AverageDuration.Seconds(),
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return fmt.Errorf("failed to encode usage: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"bytes"
+	"github.com/lazeratops/daily-go/daily/meeting"
+	"github.com/lazeratops/daily-go/daily/report"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestAggregateUsage(t *testing.T) {
+	t.Parallel()
+	// Monday 30 January 2023, ISO week 5
+	day := time.Date(2023, 1, 30, 10, 0, 0, 0, time.UTC)
+	meetings := []meeting.Meeting{
+		{
+			ID:        "m1",
+			Room:      "standup-Xk3fQ9zL2mPa7RtB8wYc",
+			StartTime: day,
+			Duration:  30 * time.Minute,
+			Participants: []meeting.Participant{
+				{ParticipantID: "p1", JoinTime: day, Duration: 30 * time.Minute},
+				{ParticipantID: "p2", JoinTime: day.Add(5 * time.Minute), Duration: 10 * time.Minute},
+				{ParticipantID: "p3", JoinTime: day.Add(10 * time.Minute), Duration: 20 * time.Minute},
+			},
+		},
+		{
+			ID:        "m2",
+			Room:      "standup-hT4nV_1sEo6JdG0qLr-u",
+			StartTime: day.Add(24 * time.Hour),
+			Duration:  10 * time.Minute,
+			Participants: []meeting.Participant{
+				{ParticipantID: "p4", JoinTime: day.Add(24 * time.Hour), Duration: 5 * time.Minute},
+				// Joins exactly as p4 leaves
+				{ParticipantID: "p5", JoinTime: day.Add(24*time.Hour + 5*time.Minute), Duration: 5 * time.Minute},
+			},
+		},
+		{
+			ID:        "m3",
+			Room:      "retro",
+			StartTime: day.Add(7 * 24 * time.Hour),
+			Duration:  time.Hour,
+			Participants: []meeting.Participant{
+				{ParticipantID: "p6", JoinTime: day.Add(7 * 24 * time.Hour), Duration: time.Hour},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		opts    report.UsageOpts
+		want    []report.Usage
+		wantErr bool
+	}{
+		{
+			name: "room",
+			opts: report.UsageOpts{GroupBy: report.GroupByRoom},
+			want: []report.Usage{
+				{Group: "retro", Meetings: 1, ParticipantMinutes: 60, PeakConcurrency: 1, AverageDuration: time.Hour},
+				{Group: "standup-Xk3fQ9zL2mPa7RtB8wYc", Meetings: 1, ParticipantMinutes: 60, PeakConcurrency: 3, AverageDuration: 30 * time.Minute},
+				{Group: "standup-hT4nV_1sEo6JdG0qLr-u", Meetings: 1, ParticipantMinutes: 10, PeakConcurrency: 1, AverageDuration: 10 * time.Minute},
+			},
+		},
+		{
+			name: "default-prefix",
+			opts: report.UsageOpts{GroupBy: report.GroupByPrefix},
+			want: []report.Usage{
+				{Group: "retro", Meetings: 1, ParticipantMinutes: 60, PeakConcurrency: 1, AverageDuration: time.Hour},
+				{Group: "standup-", Meetings: 2, ParticipantMinutes: 70, PeakConcurrency: 3, AverageDuration: 20 * time.Minute},
+			},
+		},
+		{
+			name: "custom-prefix",
+			opts: report.UsageOpts{
+				GroupBy: report.GroupByPrefix,
+				Prefix: func(roomName string) string {
+					return roomName[:1]
+				},
+			},
+			want: []report.Usage{
+				{Group: "r", Meetings: 1, ParticipantMinutes: 60, PeakConcurrency: 1, AverageDuration: time.Hour},
+				{Group: "s", Meetings: 2, ParticipantMinutes: 70, PeakConcurrency: 3, AverageDuration: 20 * time.Minute},
+			},
+		},
+		{
+			name: "day",
+			opts: report.UsageOpts{GroupBy: report.GroupByDay},
+			want: []report.Usage{
+				{Group: "2023-01-30", Meetings: 1, ParticipantMinutes: 60, PeakConcurrency: 3, AverageDuration: 30 * time.Minute},
+				{Group: "2023-01-31", Meetings: 1, ParticipantMinutes: 10, PeakConcurrency: 1, AverageDuration: 10 * time.Minute},
+				{Group: "2023-02-06", Meetings: 1, ParticipantMinutes: 60, PeakConcurrency: 1, AverageDuration: time.Hour},
+			},
+		},
+		{
+			name: "day-in-location",
+			opts: report.UsageOpts{
+				GroupBy:  report.GroupByDay,
+				Location: time.FixedZone("UTC-11", -11*60*60),
+			},
+			want: []report.Usage{
+				{Group: "2023-01-29", Meetings: 1, ParticipantMinutes: 60, PeakConcurrency: 3, AverageDuration: 30 * time.Minute},
+				{Group: "2023-01-30", Meetings: 1, ParticipantMinutes: 10, PeakConcurrency: 1, AverageDuration: 10 * time.Minute},
+				{Group: "2023-02-05", Meetings: 1, ParticipantMinutes: 60, PeakConcurrency: 1, AverageDuration: time.Hour},
+			},
+		},
+		{
+			name: "week",
+			opts: report.UsageOpts{GroupBy: report.GroupByWeek},
+			want: []report.Usage{
+				{Group: "2023-W05", Meetings: 2, ParticipantMinutes: 70, PeakConcurrency: 3, AverageDuration: 20 * time.Minute},
+				{Group: "2023-W06", Meetings: 1, ParticipantMinutes: 60, PeakConcurrency: 1, AverageDuration: time.Hour},
+			},
+		},
+		{
+			name:    "unknown-grouping",
+			opts:    report.UsageOpts{GroupBy: "month"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := report.AggregateUsage(meetings, tc.opts)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRandomSuffixPrefix(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		roomName string
+		want     string
+	}{
+		{roomName: "standup-Xk3fQ9zL2mPa7RtB8wYc", want: "standup-"},
+		{roomName: "hT4nV_1sEo6JdG0qLr-u", want: ""},
+		{roomName: "retro", want: "retro"},
+		// Long names without a generated suffix are kept whole
+		{roomName: "company-all-hands-weekly", want: "company-all-hands-weekly"},
+		{roomName: "abcdefghijklmnopqrst", want: "abcdefghijklmnopqrst"},
+		// Prefixes are at most 10 characters long
+		{roomName: "engineering-sync-Xk3fQ9zL2mPa7RtB8wYc", want: "engineering-sync-Xk3fQ9zL2mPa7RtB8wYc"},
+		// Suffixes only contain URL-safe characters
+		{roomName: "standup-Xk3fQ9zL2mPa7RtB8w.c", want: "standup-Xk3fQ9zL2mPa7RtB8w.c"},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, report.RandomSuffixPrefix(tc.roomName), tc.roomName)
+	}
+}
+
+func TestWriteUsage(t *testing.T) {
+	t.Parallel()
+	usages := []report.Usage{
+		{Group: "retro", Meetings: 2, ParticipantMinutes: 90.25, PeakConcurrency: 4, AverageDuration: 45 * time.Minute},
+	}
+
+	var csvOut bytes.Buffer
+	require.NoError(t, report.WriteCSV(&csvOut, usages))
+	require.Equal(t, "Group,Meetings,Participant minutes,Peak concurrency,Average duration\nretro,2,90.2,4,45m0s\n", csvOut.String())
+
+	var jsonOut bytes.Buffer
+	require.NoError(t, report.WriteJSON(&jsonOut, usages))
+	require.JSONEq(t, `[{"group":"retro","meetings":2,"participant_minutes":90.25,"peak_concurrency":4,"average_duration_seconds":2700}]`, jsonOut.String())
+}
@@ -0,0 +1,166 @@
+// Package report builds usage reports from Daily meeting history
+package report
+
+import (
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/meeting"
+	"github.com/lazeratops/daily-go/daily/room"
+	"sort"
+	"time"
+)
+
+// GroupBy is what usage is aggregated by
+type GroupBy string
+
+const (
+	GroupByRoom   GroupBy = "room"
+	GroupByPrefix GroupBy = "prefix"
+	GroupByDay    GroupBy = "day"
+	GroupByWeek   GroupBy = "week"
+)
+
+// UsageOpts represents parameters for aggregating usage
+type UsageOpts struct {
+	GroupBy GroupBy
+	// Prefix returns the prefix of the given room name when grouping by
+	// prefix. Defaults to RandomSuffixPrefix, which only finds prefixes
+	// of rooms created with room.CreateWithPrefix.
+	Prefix func(roomName string) string
+	// Location is the time zone days and weeks are
+	// counted in when grouping by time. Defaults to UTC.
+	Location *time.Location
+}
+
+// Usage is the aggregated usage of a group of meetings
+type Usage struct {
+	// Group is the room name, prefix, day (2006-01-02)
+	// or ISO week (2006-W01) the usage belongs to
+	Group              string
+	Meetings           int
+	ParticipantMinutes float64
+	// PeakConcurrency is the highest number of participants
+	// present at once across the group's meetings
+	PeakConcurrency int
+	AverageDuration time.Duration
+}
+
+// RandomSuffixPrefix returns the given room name without the random
+// suffix added by room.CreateWithPrefix. Names which do not look
+// generated by it are returned as they are, so rooms named some other
+// way need an explicit UsageOpts.Prefix to be grouped by prefix.
+func RandomSuffixPrefix(roomName string) string {
+	if prefix, ok := room.SplitGeneratedName(roomName); ok {
+		return prefix
+	}
+	return roomName
+}
+
+// AggregateUsage aggregates the usage of the given meetings into groups,
+// ordered by group. Meetings are grouped by time according to the time
+// they started.
+func AggregateUsage(meetings []meeting.Meeting, opts UsageOpts) ([]Usage, error) {
+	groupOf, err := groupFunc(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	grouped := make(map[string][]meeting.Meeting)
+	for _, m := range meetings {
+		g := groupOf(m)
+		grouped[g] = append(grouped[g], m)
+	}
+
+	usages := make([]Usage, 0, len(grouped))
+	for g, ms := range grouped {
+		usages = append(usages, aggregateGroup(g, ms))
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].Group < usages[j].Group
+	})
+	return usages, nil
+}
+
+func groupFunc(opts UsageOpts) (func(meeting.Meeting) string, error) {
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	switch opts.GroupBy {
+	case GroupByRoom:
+		return func(m meeting.Meeting) string {
+			return m.Room
+		}, nil
+	case GroupByPrefix:
+		prefix := opts.Prefix
+		if prefix == nil {
+			prefix = RandomSuffixPrefix
+		}
+		return func(m meeting.Meeting) string {
+			return prefix(m.Room)
+		}, nil
+	case GroupByDay:
+		return func(m meeting.Meeting) string {
+			return m.StartTime.In(loc).Format("2006-01-02")
+		}, nil
+	case GroupByWeek:
+		return func(m meeting.Meeting) string {
+			year, week := m.StartTime.In(loc).ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown usage grouping '%s'", opts.GroupBy)
+}
+
+func aggregateGroup(group string, meetings []meeting.Meeting) Usage {
+	u := Usage{
+		Group:    group,
+		Meetings: len(meetings),
+	}
+
+	var totalDuration time.Duration
+	var participantTime time.Duration
+	var edges []edge
+	for _, m := range meetings {
+		totalDuration += m.Duration
+		for _, p := range m.Participants {
+			participantTime += p.Duration
+			edges = append(edges,
+				edge{at: p.JoinTime, delta: 1},
+				edge{at: p.GetLeaveTime(), delta: -1},
+			)
+		}
+	}
+	u.ParticipantMinutes = participantTime.Minutes()
+	if len(meetings) > 0 {
+		u.AverageDuration = totalDuration / time.Duration(len(meetings))
+	}
+	u.PeakConcurrency = peakConcurrency(edges)
+	return u
+}
+
+// edge is a participant joining (+1) or leaving (-1)
+type edge struct {
+	at    time.Time
+	delta int
+}
+
+// peakConcurrency sweeps over joins and leaves in time order to find
+// the highest number of participants present at once. Leaves sort
+// before joins at the same instant, so back-to-back sessions of the
+// same participant are not double counted.
+func peakConcurrency(edges []edge) int {
+	sort.Slice(edges, func(i, j int) bool {
+		if !edges[i].at.Equal(edges[j].at) {
+			return edges[i].at.Before(edges[j].at)
+		}
+		return edges[i].delta < edges[j].delta
+	})
+	var cur, peak int
+	for _, e := range edges {
+		cur += e.delta
+		if cur > peak {
+			peak = cur
+		}
+	}
+	return peak
+}
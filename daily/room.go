@@ -1,6 +1,7 @@
 package daily
 
 import (
+	"context"
 	"fmt"
 	"github.com/lazeratops/daily-go/daily/auth"
 	"github.com/lazeratops/daily-go/daily/room"
@@ -77,18 +78,47 @@ func (d *Daily) DeleteRoom(roomName string) error {
 	}, roomName)
 }
 
-// SendAppMessage sends an "app-message" event to the given room
-func (d *Daily) SendAppMessage(roomName string, data string, recipient *string) error {
-	r := "*"
-	if recipient != nil {
-		r = *recipient
-	}
-	return room.SendAppMessage(auth.Creds{
+// SendAppMessage sends an "app-message" event with the given
+// payload to the given room
+func (d *Daily) SendAppMessage(ctx context.Context, roomName string, payload interface{}, opts *room.SendAppMessageOpts) error {
+	return room.SendAppMessage(ctx, auth.Creds{
 		APIKey: d.apiKey,
 		APIURL: d.apiURL,
-	}, room.SendAppMessageParams{
-		RoomName:  roomName,
-		Data:      data,
-		Recipient: r,
-	})
+	}, roomName, payload, opts)
+}
+
+// AppMessageResult is the outcome of sending an app
+// message to a single room
+type AppMessageResult struct {
+	RoomName string
+	Err      error
+}
+
+// BroadcastAppMessage sends an "app-message" event with the given payload
+// to every room whose name matches the given filter. Rooms are messaged
+// concurrently, up to the configured room fan-out concurrency. A failure to
+// message one room does not prevent messaging the others; per-room
+// outcomes are returned in the same order as the matched rooms.
+func (d *Daily) BroadcastAppMessage(ctx context.Context, filter *regexp.Regexp, payload interface{}) ([]AppMessageResult, error) {
+	// Check the payload once, instead of having
+	// every room's message fail the same way
+	if err := room.ValidateAppMessage(payload); err != nil {
+		return nil, err
+	}
+
+	rooms, err := d.GetRoomsWithRegex(nil, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rooms to broadcast to: %w", err)
+	}
+
+	roomNames := make([]string, len(rooms))
+	for i, r := range rooms {
+		roomNames[i] = r.Name
+	}
+	return forEachRoom(d, roomNames, func(roomName string) AppMessageResult {
+		return AppMessageResult{
+			RoomName: roomName,
+			Err:      d.SendAppMessage(ctx, roomName, payload, nil),
+		}
+	}), nil
 }
@@ -0,0 +1,54 @@
+package room
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+)
+
+// doRoomAction makes a POST request with the given body to an
+// endpoint under the given room, such as /rooms/:name/recordings/start,
+// and returns the response body.
+func doRoomAction(ctx context.Context, creds auth.Creds, roomName string, body interface{}, paths ...string) ([]byte, error) {
+	endpoint, err := roomsEndpoint(creds.APIURL, append([]string{roomName}, paths...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyBlob, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(bodyBlob))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create POST request to room endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make room request: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+	return resBody, nil
+}
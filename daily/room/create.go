@@ -10,6 +10,18 @@ import (
 	"io"
 	"math/big"
 	"net/http"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxNamePrefixLen is the longest prefix CreateWithPrefix accepts
+	maxNamePrefixLen = 10
+	// nameSuffixLen is the length of the random suffix
+	// CreateWithPrefix appends to the prefix
+	nameSuffixLen = 20
+	// nameSuffixChars are the characters random suffixes are made of
+	nameSuffixChars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz-_"
 )
 
 type CreateParams struct {
@@ -29,8 +41,8 @@ type createRoomBody struct {
 // CreateWithPrefix creates a room with the name containing the specified
 // prefix. The rest of the name is randomized.
 func CreateWithPrefix(creds auth.Creds, params CreateParams) (*Room, error) {
-	if len(params.Prefix) > 10 {
-		return nil, fmt.Errorf("prefix too long, must be up to 10 characters")
+	if len(params.Prefix) > maxNamePrefixLen {
+		return nil, fmt.Errorf("prefix too long, must be up to %d characters", maxNamePrefixLen)
 	}
 	name, err := generateNameWithPrefix(params.Prefix)
 	if err != nil {
@@ -137,22 +149,47 @@ func concatRoomProperties(props Props, additionalProps map[string]interface{}) (
 }
 
 func generateNameWithPrefix(prefix string) (string, error) {
-	s, err := generateRandStr(20)
+	s, err := generateRandStr(nameSuffixLen)
 	if err != nil {
 		return "", err
 	}
 	return fmt.Sprintf("%s%s", prefix, s), nil
 }
 
+// SplitGeneratedName returns the prefix of a room name generated by
+// CreateWithPrefix, and reports false if the name does not look
+// generated. As suffixes are random, this is a heuristic: the suffix
+// must be made of the characters CreateWithPrefix uses and contain both
+// upper and lower case letters, which nearly all generated suffixes do
+// and few hand-picked names do.
+func SplitGeneratedName(name string) (string, bool) {
+	if len(name) < nameSuffixLen || len(name) > maxNamePrefixLen+nameSuffixLen {
+		return "", false
+	}
+	prefix, suffix := name[:len(name)-nameSuffixLen], name[len(name)-nameSuffixLen:]
+
+	var hasUpper, hasLower bool
+	for _, c := range suffix {
+		if !strings.ContainsRune(nameSuffixChars, c) {
+			return "", false
+		}
+		hasUpper = hasUpper || unicode.IsUpper(c)
+		hasLower = hasLower || unicode.IsLower(c)
+	}
+	if !hasUpper || !hasLower {
+		return "", false
+	}
+	return prefix, true
+}
+
 func generateRandStr(length int) (string, error) {
-	const chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz-_"
 	result := make([]byte, length)
 	for i := 0; i < length; i++ {
-		num, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+		num, err := rand.Int(rand.Reader, big.NewInt(int64(len(nameSuffixChars))))
 		if err != nil {
 			return "", err
 		}
-		result[i] = chars[num.Int64()]
+		result[i] = nameSuffixChars[num.Int64()]
 	}
 
 	return string(result), nil
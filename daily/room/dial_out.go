@@ -0,0 +1,110 @@
+package room
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/phone"
+	"net/url"
+)
+
+// StartDialOutOpts represents parameters for dialing out from a
+// room. Exactly one of PhoneNumber or SIPURI is required.
+type StartDialOutOpts struct {
+	// PhoneNumber is the number to call, in E.164 format
+	PhoneNumber string
+	// SIPURI is the SIP endpoint to call, e.g. "sip:bob@example.com"
+	SIPURI string
+	// DisplayName is the name the dialed participant
+	// is shown with in the meeting
+	DisplayName string
+	// CallerID is the ID of a purchased phone number to
+	// call from. Only applies when calling a phone number.
+	CallerID string
+	// Video requests video from the SIP endpoint.
+	// Only applies when calling a SIP URI.
+	Video bool
+	// UserID is the user ID the dialed participant joins with
+	UserID string
+}
+
+type startDialOutBody struct {
+	PhoneNumber string `json:"phoneNumber,omitempty"`
+	SIPURI      string `json:"sipUri,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	CallerID    string `json:"callerId,omitempty"`
+	Video       bool   `json:"video,omitempty"`
+	UserID      string `json:"userId,omitempty"`
+}
+
+type startDialOutResponse struct {
+	SessionID string `json:"sessionId"`
+}
+
+type stopDialOutBody struct {
+	SessionID string `json:"sessionId"`
+}
+
+// StartDialOut calls the given phone number or SIP URI from the
+// meeting in the given room and returns the session ID of the dialed
+// participant. The room must have dial-out enabled.
+func StartDialOut(ctx context.Context, creds auth.Creds, roomName string, opts StartDialOutOpts) (string, error) {
+	if err := opts.validate(); err != nil {
+		return "", err
+	}
+	body := startDialOutBody{
+		PhoneNumber: opts.PhoneNumber,
+		SIPURI:      opts.SIPURI,
+		DisplayName: opts.DisplayName,
+		CallerID:    opts.CallerID,
+		Video:       opts.Video,
+		UserID:      opts.UserID,
+	}
+	resBody, err := doRoomAction(ctx, creds, roomName, body, "dialOut", "start")
+	if err != nil {
+		return "", fmt.Errorf("failed to start dial-out: %w", err)
+	}
+
+	var res startDialOutResponse
+	if err := json.Unmarshal(resBody, &res); err != nil {
+		return "", NewErrFailUnmarshal(err)
+	}
+	return res.SessionID, nil
+}
+
+// StopDialOut hangs up the dialed participant with
+// the given session ID in the given room
+func StopDialOut(ctx context.Context, creds auth.Creds, roomName string, sessionID string) error {
+	if sessionID == "" {
+		return NewErrInvalidOpts(errors.New("session ID is required"))
+	}
+	if _, err := doRoomAction(ctx, creds, roomName, stopDialOutBody{SessionID: sessionID}, "dialOut", "stop"); err != nil {
+		return fmt.Errorf("failed to stop dial-out: %w", err)
+	}
+	return nil
+}
+
+func (o StartDialOutOpts) validate() error {
+	if (o.PhoneNumber == "") == (o.SIPURI == "") {
+		return NewErrInvalidOpts(errors.New("exactly one of a phone number or SIP URI is required"))
+	}
+	if o.PhoneNumber != "" {
+		if !phone.IsE164(o.PhoneNumber) {
+			return NewErrInvalidOpts(fmt.Errorf("phone number must be in E.164 format, e.g. +12025550123: '%s'", o.PhoneNumber))
+		}
+		if o.Video {
+			return NewErrInvalidOpts(errors.New("video is only supported when calling a SIP URI"))
+		}
+		return nil
+	}
+	if o.CallerID != "" {
+		return NewErrInvalidOpts(errors.New("caller ID is only supported when calling a phone number"))
+	}
+	u, err := url.Parse(o.SIPURI)
+	if err != nil || (u.Scheme != "sip" && u.Scheme != "sips") || u.Opaque == "" {
+		return NewErrInvalidOpts(fmt.Errorf("SIP URI must be a sip: or sips: URI: '%s'", o.SIPURI))
+	}
+	return nil
+}
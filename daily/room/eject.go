@@ -0,0 +1,50 @@
+package room
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+)
+
+// EjectParams identifies the participants to eject from a room.
+// At least one session ID or user ID is required.
+type EjectParams struct {
+	// IDs are participant session IDs
+	IDs []string
+	// UserIDs are the user IDs participants joined with,
+	// e.g. from a meeting token
+	UserIDs []string
+}
+
+type ejectBody struct {
+	IDs     []string `json:"ids,omitempty"`
+	UserIDs []string `json:"user_ids,omitempty"`
+}
+
+type ejectResponse struct {
+	EjectedIDs []string `json:"ejectedIds"`
+}
+
+// Eject removes the given participants from the given room
+// and returns the session IDs of the ejected participants
+func Eject(ctx context.Context, creds auth.Creds, roomName string, params EjectParams) ([]string, error) {
+	if len(params.IDs) == 0 && len(params.UserIDs) == 0 {
+		return nil, NewErrInvalidOpts(errors.New("at least one session ID or user ID is required"))
+	}
+	body := ejectBody{
+		IDs:     params.IDs,
+		UserIDs: params.UserIDs,
+	}
+	resBody, err := doRoomAction(ctx, creds, roomName, body, "eject")
+	if err != nil {
+		return nil, fmt.Errorf("failed to eject participants: %w", err)
+	}
+
+	var res ejectResponse
+	if err := json.Unmarshal(resBody, &res); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return res.EjectedIDs, nil
+}
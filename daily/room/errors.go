@@ -8,6 +8,12 @@ import (
 var (
 	ErrFailUnmarshal  = errors.New("failed to unmarshal response body into Room")
 	ErrFailRoomDelete = errors.New("failed to delete room")
+	// ErrAppMessageTooLarge is returned when app message data
+	// exceeds MaxAppMessageSize once encoded.
+	ErrAppMessageTooLarge = errors.New("app message data is too large")
+	// ErrInvalidOpts is returned when options given for a
+	// room operation are not accepted by Daily.
+	ErrInvalidOpts = errors.New("invalid options")
 )
 
 func NewErrFailUnmarshal(unmarshalErr error) error {
@@ -17,3 +23,11 @@ func NewErrFailUnmarshal(unmarshalErr error) error {
 func NewErrFailRoomDelete(deleteErr error) error {
 	return fmt.Errorf("%s: %w", deleteErr, ErrFailRoomDelete)
 }
+
+func NewErrAppMessageTooLarge(size int) error {
+	return fmt.Errorf("data is %d bytes, limit is %d bytes: %w", size, MaxAppMessageSize, ErrAppMessageTooLarge)
+}
+
+func NewErrInvalidOpts(err error) error {
+	return fmt.Errorf("%s: %w", err, ErrInvalidOpts)
+}
@@ -0,0 +1,57 @@
+package room
+
+import (
+	"errors"
+	"fmt"
+)
+
+// LayoutPreset is a Daily-provided layout for composited
+// cloud recordings and live streams.
+type LayoutPreset string
+
+const (
+	LayoutPresetDefault           LayoutPreset = "default"
+	LayoutPresetSingleParticipant LayoutPreset = "single-participant"
+	LayoutPresetActiveParticipant LayoutPreset = "active-participant"
+	LayoutPresetPortrait          LayoutPreset = "portrait"
+	LayoutPresetAudioOnly         LayoutPreset = "audio-only"
+)
+
+// Layout configures how participants are composited
+// in a cloud recording or live stream.
+type Layout struct {
+	Preset LayoutPreset `json:"preset"`
+	// MaxCamStreams limits the number of camera streams
+	// shown with the default preset.
+	MaxCamStreams int `json:"max_cam_streams,omitempty"`
+	// SessionID is the participant to show with the
+	// single-participant preset.
+	SessionID string `json:"session_id,omitempty"`
+}
+
+// Resolution is the output resolution of a cloud
+// recording or live stream, in pixels.
+type Resolution struct {
+	Width  int
+	Height int
+}
+
+func (l *Layout) validate() error {
+	switch l.Preset {
+	case LayoutPresetDefault, LayoutPresetActiveParticipant, LayoutPresetPortrait, LayoutPresetAudioOnly:
+	case LayoutPresetSingleParticipant:
+		if l.SessionID == "" {
+			return NewErrInvalidOpts(errors.New("single-participant layout requires a session ID"))
+		}
+	default:
+		return NewErrInvalidOpts(fmt.Errorf("unknown layout preset '%s'", l.Preset))
+	}
+	return nil
+}
+
+func (r *Resolution) validate() error {
+	if r.Width <= 0 || r.Height <= 0 {
+		return NewErrInvalidOpts(fmt.Errorf("invalid resolution %dx%d", r.Width, r.Height))
+	}
+	return nil
+}
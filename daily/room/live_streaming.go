@@ -0,0 +1,147 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"net/url"
+)
+
+// StartLiveStreamingOpts represents parameters
+// for starting a live stream.
+type StartLiveStreamingOpts struct {
+	// RTMPURLs are the rtmp:// or rtmps:// endpoints to stream to.
+	// At least one is required.
+	RTMPURLs   []string
+	Layout     *Layout
+	Resolution *Resolution
+	// VideoBitrate is in kilobits per second
+	VideoBitrate int
+	// AudioBitrate is in kilobits per second
+	AudioBitrate int
+	// InstanceID identifies the live stream when multiple
+	// live streams of the same room are running at once.
+	InstanceID string
+}
+
+// UpdateLiveStreamingOpts represents parameters
+// for updating an ongoing live stream.
+type UpdateLiveStreamingOpts struct {
+	Layout     Layout
+	InstanceID string
+}
+
+// StopLiveStreamingOpts represents optional
+// parameters for stopping a live stream.
+type StopLiveStreamingOpts struct {
+	InstanceID string
+}
+
+type startLiveStreamingBody struct {
+	RTMPURLs     []string `json:"rtmpUrl"`
+	Layout       *Layout  `json:"layout,omitempty"`
+	Width        int      `json:"width,omitempty"`
+	Height       int      `json:"height,omitempty"`
+	VideoBitrate int      `json:"videoBitrate,omitempty"`
+	AudioBitrate int      `json:"audioBitrate,omitempty"`
+	InstanceID   string   `json:"instanceId,omitempty"`
+}
+
+type updateLiveStreamingBody struct {
+	Layout     Layout `json:"layout"`
+	InstanceID string `json:"instanceId,omitempty"`
+}
+
+type stopLiveStreamingBody struct {
+	InstanceID string `json:"instanceId,omitempty"`
+}
+
+// StartLiveStreaming starts streaming the given room to RTMP endpoints
+func StartLiveStreaming(ctx context.Context, creds auth.Creds, roomName string, opts StartLiveStreamingOpts) error {
+	body, err := makeStartLiveStreamingBody(opts)
+	if err != nil {
+		return err
+	}
+	if _, err := doRoomAction(ctx, creds, roomName, body, "live-streaming", "start"); err != nil {
+		return fmt.Errorf("failed to start live streaming: %w", err)
+	}
+	return nil
+}
+
+// UpdateLiveStreaming updates the layout of an ongoing
+// live stream of the given room
+func UpdateLiveStreaming(ctx context.Context, creds auth.Creds, roomName string, opts UpdateLiveStreamingOpts) error {
+	if err := opts.Layout.validate(); err != nil {
+		return err
+	}
+	body := updateLiveStreamingBody{
+		Layout:     opts.Layout,
+		InstanceID: opts.InstanceID,
+	}
+	if _, err := doRoomAction(ctx, creds, roomName, body, "live-streaming", "update"); err != nil {
+		return fmt.Errorf("failed to update live streaming: %w", err)
+	}
+	return nil
+}
+
+// StopLiveStreaming stops a live stream of the given room
+func StopLiveStreaming(ctx context.Context, creds auth.Creds, roomName string, opts *StopLiveStreamingOpts) error {
+	var body stopLiveStreamingBody
+	if opts != nil {
+		body.InstanceID = opts.InstanceID
+	}
+	if _, err := doRoomAction(ctx, creds, roomName, body, "live-streaming", "stop"); err != nil {
+		return fmt.Errorf("failed to stop live streaming: %w", err)
+	}
+	return nil
+}
+
+func makeStartLiveStreamingBody(opts StartLiveStreamingOpts) (*startLiveStreamingBody, error) {
+	if len(opts.RTMPURLs) == 0 {
+		return nil, NewErrInvalidOpts(errors.New("at least one RTMP URL is required"))
+	}
+	for _, u := range opts.RTMPURLs {
+		if err := validateRTMPURL(u); err != nil {
+			return nil, err
+		}
+	}
+	if opts.VideoBitrate < 0 || opts.AudioBitrate < 0 {
+		return nil, NewErrInvalidOpts(errors.New("bitrate cannot be negative"))
+	}
+
+	body := &startLiveStreamingBody{
+		RTMPURLs:     opts.RTMPURLs,
+		VideoBitrate: opts.VideoBitrate,
+		AudioBitrate: opts.AudioBitrate,
+		InstanceID:   opts.InstanceID,
+	}
+	if opts.Layout != nil {
+		if err := opts.Layout.validate(); err != nil {
+			return nil, err
+		}
+		body.Layout = opts.Layout
+	}
+	if opts.Resolution != nil {
+		if err := opts.Resolution.validate(); err != nil {
+			return nil, err
+		}
+		body.Width = opts.Resolution.Width
+		body.Height = opts.Resolution.Height
+	}
+	return body, nil
+}
+
+func validateRTMPURL(rtmpURL string) error {
+	u, err := url.Parse(rtmpURL)
+	if err != nil {
+		return NewErrInvalidOpts(fmt.Errorf("invalid RTMP URL '%s': %w", rtmpURL, err))
+	}
+	if u.Scheme != "rtmp" && u.Scheme != "rtmps" {
+		return NewErrInvalidOpts(fmt.Errorf("RTMP URL '%s' must use the rtmp or rtmps scheme", rtmpURL))
+	}
+	if u.Host == "" {
+		return NewErrInvalidOpts(fmt.Errorf("RTMP URL '%s' has no host", rtmpURL))
+	}
+	return nil
+}
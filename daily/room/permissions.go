@@ -0,0 +1,139 @@
+package room
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+)
+
+// AllParticipants is the permissions key which applies
+// an update to every participant in the room
+const AllParticipants = "*"
+
+// MediaType is a kind of media a participant can send
+type MediaType string
+
+const (
+	MediaVideo       MediaType = "video"
+	MediaAudio       MediaType = "audio"
+	MediaScreenVideo MediaType = "screenVideo"
+	MediaScreenAudio MediaType = "screenAudio"
+	MediaCustomVideo MediaType = "customVideo"
+	MediaCustomAudio MediaType = "customAudio"
+)
+
+// AdminRole is an administrative capability a participant can hold
+type AdminRole string
+
+const (
+	AdminParticipants  AdminRole = "participants"
+	AdminStreaming     AdminRole = "streaming"
+	AdminTranscription AdminRole = "transcription"
+)
+
+// SendPermission controls which media a participant can send.
+// If Media is set, only those media types can be sent. Otherwise,
+// All controls whether the participant can send any media at all.
+type SendPermission struct {
+	All   bool
+	Media []MediaType
+}
+
+// AdminPermission controls which administrative capabilities a
+// participant has. If Roles is set, the participant has only those
+// roles. Otherwise, All controls whether they have every role or none.
+type AdminPermission struct {
+	All   bool
+	Roles []AdminRole
+}
+
+// Permissions are the permissions to update for a participant.
+// Fields left nil are not changed.
+type Permissions struct {
+	HasPresence *bool            `json:"hasPresence,omitempty"`
+	CanSend     *SendPermission  `json:"canSend,omitempty"`
+	CanAdmin    *AdminPermission `json:"canAdmin,omitempty"`
+}
+
+type updatePermissionsBody struct {
+	Data map[string]Permissions `json:"data"`
+}
+
+// MarshalJSON encodes the permission as either
+// a boolean or a list of media types
+func (s SendPermission) MarshalJSON() ([]byte, error) {
+	if len(s.Media) > 0 {
+		return json.Marshal(s.Media)
+	}
+	return json.Marshal(s.All)
+}
+
+// MarshalJSON encodes the permission as either
+// a boolean or a list of admin roles
+func (a AdminPermission) MarshalJSON() ([]byte, error) {
+	if len(a.Roles) > 0 {
+		return json.Marshal(a.Roles)
+	}
+	return json.Marshal(a.All)
+}
+
+// UpdatePermissions updates the permissions of participants in the given
+// room. The given permissions are keyed by participant session ID, or by
+// AllParticipants to update everyone in the room.
+func UpdatePermissions(ctx context.Context, creds auth.Creds, roomName string, perms map[string]Permissions) error {
+	if err := validatePermissions(perms); err != nil {
+		return err
+	}
+	body := updatePermissionsBody{Data: perms}
+	if _, err := doRoomAction(ctx, creds, roomName, body, "update-permissions"); err != nil {
+		return fmt.Errorf("failed to update permissions: %w", err)
+	}
+	return nil
+}
+
+func validatePermissions(perms map[string]Permissions) error {
+	if len(perms) == 0 {
+		return NewErrInvalidOpts(errors.New("at least one participant's permissions are required"))
+	}
+	for sessionID, p := range perms {
+		if sessionID == "" {
+			return NewErrInvalidOpts(errors.New("permissions must be keyed by session ID or '*'"))
+		}
+		if p.HasPresence == nil && p.CanSend == nil && p.CanAdmin == nil {
+			return NewErrInvalidOpts(fmt.Errorf("no permissions given for '%s'", sessionID))
+		}
+		if p.CanSend != nil {
+			for _, m := range p.CanSend.Media {
+				if !isValidMediaType(m) {
+					return NewErrInvalidOpts(fmt.Errorf("unknown media type '%s' for '%s'", m, sessionID))
+				}
+			}
+		}
+		if p.CanAdmin != nil {
+			for _, r := range p.CanAdmin.Roles {
+				if !isValidAdminRole(r) {
+					return NewErrInvalidOpts(fmt.Errorf("unknown admin role '%s' for '%s'", r, sessionID))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func isValidMediaType(m MediaType) bool {
+	switch m {
+	case MediaVideo, MediaAudio, MediaScreenVideo, MediaScreenAudio, MediaCustomVideo, MediaCustomAudio:
+		return true
+	}
+	return false
+}
+
+func isValidAdminRole(r AdminRole) bool {
+	switch r {
+	case AdminParticipants, AdminStreaming, AdminTranscription:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"time"
+)
+
+// RecordingType is the kind of recording Daily makes of a room
+type RecordingType string
+
+const (
+	// RecordingTypeCloud is a single composited recording
+	RecordingTypeCloud RecordingType = "cloud"
+	// RecordingTypeRawTracks records each participant's
+	// tracks to separate files
+	RecordingTypeRawTracks RecordingType = "raw-tracks"
+)
+
+// StartRecordingOpts represents optional parameters
+// for starting a recording.
+type StartRecordingOpts struct {
+	// Type defaults to cloud recording if empty
+	Type   RecordingType
+	Layout *Layout
+	// MaxDuration is rounded down to whole seconds
+	MaxDuration time.Duration
+	Resolution  *Resolution
+	// InstanceID identifies the recording when multiple
+	// recordings of the same room are running at once.
+	InstanceID string
+}
+
+// StopRecordingOpts represents optional parameters
+// for stopping a recording.
+type StopRecordingOpts struct {
+	Type       RecordingType
+	InstanceID string
+}
+
+// UpdateRecordingOpts represents parameters
+// for updating an ongoing recording.
+type UpdateRecordingOpts struct {
+	Layout     Layout
+	InstanceID string
+}
+
+type startRecordingBody struct {
+	Type        RecordingType `json:"type,omitempty"`
+	Layout      *Layout       `json:"layout,omitempty"`
+	MaxDuration int64         `json:"maxDuration,omitempty"`
+	Width       int           `json:"width,omitempty"`
+	Height      int           `json:"height,omitempty"`
+	InstanceID  string        `json:"instanceId,omitempty"`
+}
+
+type stopRecordingBody struct {
+	Type       RecordingType `json:"type,omitempty"`
+	InstanceID string        `json:"instanceId,omitempty"`
+}
+
+type updateRecordingBody struct {
+	Layout     Layout `json:"layout"`
+	InstanceID string `json:"instanceId,omitempty"`
+}
+
+// StartRecording starts a recording of the given room
+func StartRecording(ctx context.Context, creds auth.Creds, roomName string, opts *StartRecordingOpts) error {
+	body, err := makeStartRecordingBody(opts)
+	if err != nil {
+		return err
+	}
+	if _, err := doRoomAction(ctx, creds, roomName, body, "recordings", "start"); err != nil {
+		return fmt.Errorf("failed to start recording: %w", err)
+	}
+	return nil
+}
+
+// StopRecording stops a recording of the given room
+func StopRecording(ctx context.Context, creds auth.Creds, roomName string, opts *StopRecordingOpts) error {
+	var body stopRecordingBody
+	if opts != nil {
+		if err := validateRecordingType(opts.Type); err != nil {
+			return err
+		}
+		body.Type = opts.Type
+		body.InstanceID = opts.InstanceID
+	}
+	if _, err := doRoomAction(ctx, creds, roomName, body, "recordings", "stop"); err != nil {
+		return fmt.Errorf("failed to stop recording: %w", err)
+	}
+	return nil
+}
+
+// UpdateRecording updates the layout of an ongoing
+// recording of the given room
+func UpdateRecording(ctx context.Context, creds auth.Creds, roomName string, opts UpdateRecordingOpts) error {
+	if err := opts.Layout.validate(); err != nil {
+		return err
+	}
+	body := updateRecordingBody{
+		Layout:     opts.Layout,
+		InstanceID: opts.InstanceID,
+	}
+	if _, err := doRoomAction(ctx, creds, roomName, body, "recordings", "update"); err != nil {
+		return fmt.Errorf("failed to update recording: %w", err)
+	}
+	return nil
+}
+
+func makeStartRecordingBody(opts *StartRecordingOpts) (*startRecordingBody, error) {
+	body := &startRecordingBody{}
+	if opts == nil {
+		return body, nil
+	}
+	if err := validateRecordingType(opts.Type); err != nil {
+		return nil, err
+	}
+	body.Type = opts.Type
+	body.InstanceID = opts.InstanceID
+
+	if opts.MaxDuration < 0 {
+		return nil, NewErrInvalidOpts(fmt.Errorf("max duration cannot be negative"))
+	}
+	body.MaxDuration = int64(opts.MaxDuration / time.Second)
+
+	if opts.Layout != nil {
+		if err := opts.Layout.validate(); err != nil {
+			return nil, err
+		}
+		body.Layout = opts.Layout
+	}
+	if opts.Resolution != nil {
+		if err := opts.Resolution.validate(); err != nil {
+			return nil, err
+		}
+		body.Width = opts.Resolution.Width
+		body.Height = opts.Resolution.Height
+	}
+	return body, nil
+}
+
+func validateRecordingType(t RecordingType) error {
+	switch t {
+	case "", RecordingTypeCloud, RecordingTypeRawTracks:
+		return nil
+	}
+	return NewErrInvalidOpts(fmt.Errorf("unknown recording type '%s'", t))
+}
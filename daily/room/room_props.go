@@ -14,10 +14,46 @@ type Props struct {
 	MaxParticipants int   `json:"max_participants,omitempty"`
 	StartAudioOff   bool  `json:"start_audio_off"`
 	StartVideoOff   bool  `json:"start_video_off"`
+	// EnableDialout allows dialing out to phone
+	// numbers and SIP URIs from the room
+	EnableDialout bool `json:"enable_dialout,omitempty"`
+	// Dialin configures PSTN dial-in to the room
+	Dialin *DialinProps `json:"dialin,omitempty"`
+	// SIP configures SIP dial-in to the room
+	SIP *SIPProps `json:"sip,omitempty"`
+}
+
+// SIPMode is how SIP endpoints connect to a room
+type SIPMode string
+
+const (
+	SIPModeDialIn SIPMode = "dial-in"
+)
+
+// DialinProps configures PSTN dial-in to a room
+type DialinProps struct {
+	// DisplayName is the name phone participants
+	// are shown with in the meeting
+	DisplayName string `json:"display_name,omitempty"`
+	// WaitForMeetingStart keeps callers on hold until
+	// someone has joined the meeting
+	WaitForMeetingStart bool `json:"wait_for_meeting_start,omitempty"`
+}
+
+// SIPProps configures SIP dial-in to a room
+type SIPProps struct {
+	// DisplayName is the name SIP participants
+	// are shown with in the meeting
+	DisplayName string  `json:"display_name,omitempty"`
+	Video       bool    `json:"video"`
+	SIPMode     SIPMode `json:"sip_mode,omitempty"`
+	// NumEndpoints is the number of SIP endpoints
+	// that can be connected to the room at once
+	NumEndpoints int `json:"num_endpoints,omitempty"`
 }
 
 func GetRoomPropsKeys() []string {
-	return []string{"exp", "max_participants", "start_audio_off", "start_video_off"}
+	return []string{"exp", "max_participants", "start_audio_off", "start_video_off", "enable_dialout", "dialin", "sip"}
 }
 
 // SetExpiry sets the room expiry as a Unix timestamp
@@ -2,6 +2,7 @@ package room
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/lazeratops/daily-go/daily/auth"
@@ -10,29 +11,46 @@ import (
 	"net/http"
 )
 
-type SendAppMessageParams struct {
-	RoomName  string
-	Data      string
+const (
+	// RecipientBroadcast is the app message recipient which
+	// sends the message to all participants in the room.
+	RecipientBroadcast = "*"
+	// MaxAppMessageSize is the maximum size in bytes of JSON-encoded
+	// app message data accepted by Daily.
+	MaxAppMessageSize = 4096
+)
+
+// SendAppMessageOpts represents optional parameters for
+// sending an app message.
+type SendAppMessageOpts struct {
+	// Recipient is the session ID of the participant to
+	// send the message to. If empty, the message is
+	// broadcast to all participants.
 	Recipient string
 }
+
 type sendAppMessageBody struct {
-	Data      string `json:"privacy,omitempty"`
-	Recipient string `json:"properties,omitempty"`
+	Data      json.RawMessage `json:"data"`
+	Recipient string          `json:"recipient"`
 }
 
-func SendAppMessage(creds auth.Creds, params SendAppMessageParams) error {
-	endpoint, err := roomsEndpoint(creds.APIURL, params.RoomName)
+// SendAppMessage sends an "app-message" event with the given
+// payload to participants in the given room.
+func SendAppMessage[T any](ctx context.Context, creds auth.Creds, roomName string, payload T, opts *SendAppMessageOpts) error {
+	reqBody, err := makeSendAppMessageBody(payload, opts)
+	if err != nil {
+		return err
+	}
+
+	endpoint, err := roomsEndpoint(creds.APIURL, roomName, "send-app-message")
 	if err != nil {
 		return err
 	}
 
-	body := sendAppMessageBody{Data: params.Data, Recipient: params.Recipient}
-	bodyBlob, err := json.Marshal(body)
-	reqBody := bytes.NewBuffer(bodyBlob)
 	// Make the actual HTTP request
-	req, err := http.NewRequest("POST", endpoint, reqBody)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, reqBody)
 	if err != nil {
-		return fmt.Errorf("failed to create POST request to room endpoint: %w", err)
+		return fmt.Errorf("failed to create POST request to send-app-message endpoint: %w", err)
 	}
 
 	// Prepare auth and content-type headers for request
@@ -41,8 +59,9 @@ func SendAppMessage(creds auth.Creds, params SendAppMessageParams) error {
 	// Do the thing!!!
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to update room: %w", err)
+		return fmt.Errorf("failed to send app message: %w", err)
 	}
+	defer res.Body.Close()
 
 	// Parse the response
 	resBody, err := io.ReadAll(res.Body)
@@ -55,3 +74,42 @@ func SendAppMessage(creds auth.Creds, params SendAppMessageParams) error {
 	}
 	return nil
 }
+
+// ValidateAppMessage checks that the given payload can be sent as
+// app message data, e.g. before sending it to many rooms.
+func ValidateAppMessage[T any](payload T) error {
+	_, err := marshalAppMessageData(payload)
+	return err
+}
+
+func marshalAppMessageData[T any](payload T) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal app message payload: %w", err)
+	}
+	if len(data) > MaxAppMessageSize {
+		return nil, NewErrAppMessageTooLarge(len(data))
+	}
+	return data, nil
+}
+
+func makeSendAppMessageBody[T any](payload T, opts *SendAppMessageOpts) (*bytes.Buffer, error) {
+	data, err := marshalAppMessageData(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient := RecipientBroadcast
+	if opts != nil && opts.Recipient != "" {
+		recipient = opts.Recipient
+	}
+
+	bodyBlob, err := json.Marshal(sendAppMessageBody{
+		Data:      data,
+		Recipient: recipient,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+	return bytes.NewBuffer(bodyBlob), nil
+}
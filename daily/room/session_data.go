@@ -0,0 +1,146 @@
+package room
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+)
+
+// MergeStrategy is how new session data is
+// combined with a room's existing session data
+type MergeStrategy string
+
+const (
+	// MergeReplace replaces all existing session data
+	MergeReplace MergeStrategy = "replace"
+	// MergeShallow sets the top-level keys of the new
+	// session data, leaving all other keys as they are
+	MergeShallow MergeStrategy = "shallow-merge"
+)
+
+// SetSessionDataOpts represents optional parameters
+// for setting session data
+type SetSessionDataOpts struct {
+	// MergeStrategy defaults to MergeReplace
+	MergeStrategy MergeStrategy
+	// KeysToDelete are top-level keys to remove from the
+	// existing session data. Requires MergeShallow.
+	KeysToDelete []string
+}
+
+type getSessionDataResponse struct {
+	Data json.RawMessage `json:"data"`
+}
+
+type setSessionDataBody struct {
+	Data          json.RawMessage `json:"data"`
+	MergeStrategy MergeStrategy   `json:"mergeStrategy,omitempty"`
+	KeysToDelete  []string        `json:"keysToDelete,omitempty"`
+}
+
+// GetSessionData returns the session data of the ongoing
+// meeting in the given room, decoded into T
+func GetSessionData[T any](ctx context.Context, creds auth.Creds, roomName string) (T, error) {
+	var data T
+	endpoint, err := roomsEndpoint(creds.APIURL, roomName, "get-session-data")
+	if err != nil {
+		return data, err
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return data, fmt.Errorf("failed to create GET request to get-session-data endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return data, fmt.Errorf("failed to get session data: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return data, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return data, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+
+	var sdr getSessionDataResponse
+	if err := json.Unmarshal(resBody, &sdr); err != nil {
+		return data, NewErrFailUnmarshal(err)
+	}
+	// No session data has been set yet
+	if len(sdr.Data) == 0 || string(sdr.Data) == "null" {
+		return data, nil
+	}
+	if err := json.Unmarshal(sdr.Data, &data); err != nil {
+		return data, NewErrFailUnmarshal(err)
+	}
+	return data, nil
+}
+
+// SetSessionData sets the session data of the ongoing meeting
+// in the given room. Session data must encode to a JSON object.
+func SetSessionData[T any](ctx context.Context, creds auth.Creds, roomName string, data T, opts *SetSessionDataOpts) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal session data: %w", err)
+	}
+	// Session data is keyed, so it must be an object
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &obj); err != nil || obj == nil {
+		return NewErrInvalidOpts(fmt.Errorf("session data must encode to a JSON object"))
+	}
+
+	body := setSessionDataBody{
+		Data: encoded,
+	}
+	if opts != nil {
+		body.MergeStrategy = opts.MergeStrategy
+		body.KeysToDelete = opts.KeysToDelete
+	}
+	switch body.MergeStrategy {
+	case "", MergeReplace:
+		if len(body.KeysToDelete) > 0 {
+			return NewErrInvalidOpts(fmt.Errorf("deleting keys requires the %s merge strategy", MergeShallow))
+		}
+	case MergeShallow:
+	default:
+		return NewErrInvalidOpts(fmt.Errorf("unknown merge strategy '%s'", body.MergeStrategy))
+	}
+
+	if _, err := doRoomAction(ctx, creds, roomName, body, "set-session-data"); err != nil {
+		return fmt.Errorf("failed to set session data: %w", err)
+	}
+	return nil
+}
+
+// PatchSessionData sets the given top-level keys of the session data
+// of the ongoing meeting in the given room and deletes the given keys,
+// leaving all other keys as they are
+func PatchSessionData(ctx context.Context, creds auth.Creds, roomName string, set map[string]interface{}, deleteKeys []string) error {
+	if set == nil {
+		set = map[string]interface{}{}
+	}
+	for _, k := range deleteKeys {
+		if _, ok := set[k]; ok {
+			return NewErrInvalidOpts(fmt.Errorf("key '%s' cannot be both set and deleted", k))
+		}
+	}
+	return SetSessionData(ctx, creds, roomName, set, &SetSessionDataOpts{
+		MergeStrategy: MergeShallow,
+		KeysToDelete:  deleteKeys,
+	})
+}
@@ -1,62 +1,110 @@
 package tests
 
 import (
+	"context"
+	"encoding/json"
 	"github.com/lazeratops/daily-go/daily/auth"
 	"github.com/lazeratops/daily-go/daily/errors"
 	"github.com/lazeratops/daily-go/daily/room"
 	"github.com/stretchr/testify/require"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
+type testAppMessage struct {
+	Kind    string `json:"kind"`
+	Content string `json:"content"`
+}
+
 func TestSendAppMessage(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
-		name    string
-		params  room.SendAppMessageParams
-		retCode int
-		retBody string
-		wantErr error
+		name     string
+		payload  testAppMessage
+		opts     *room.SendAppMessageOpts
+		retCode  int
+		retBody  string
+		wantErr  error
+		wantBody string
 	}{
 		{
-			name: "success",
-			params: room.SendAppMessageParams{
-				Data:      "my data",
-				Recipient: "*",
+			name: "broadcast",
+			payload: testAppMessage{
+				Kind:    "announcement",
+				Content: "my data",
 			},
 			retCode: 200,
 			retBody: `
 				{
-				  "sent": "true"
+				  "sent": true
 				}`,
+			wantBody: `{"data":{"kind":"announcement","content":"my data"},"recipient":"*"}`,
+		},
+		{
+			name: "single recipient",
+			payload: testAppMessage{
+				Kind:    "dm",
+				Content: "my data",
+			},
+			opts: &room.SendAppMessageOpts{
+				Recipient: "3ac8bd82-f9b2-4b5d-9c22-9ab1d01b5b8f",
+			},
+			retCode: 200,
+			retBody: `
+				{
+				  "sent": true
+				}`,
+			wantBody: `{"data":{"kind":"dm","content":"my data"},"recipient":"3ac8bd82-f9b2-4b5d-9c22-9ab1d01b5b8f"}`,
+		},
+		{
+			name: "too large",
+			payload: testAppMessage{
+				Content: strings.Repeat("a", room.MaxAppMessageSize),
+			},
+			wantErr: room.ErrAppMessageTooLarge,
 		},
 		{
 			name: "failure",
-			params: room.SendAppMessageParams{
-				Data:      "my data",
-				Recipient: "*",
+			payload: testAppMessage{
+				Content: "my data",
 			},
-			retCode: 400,
-			wantErr: errors.ErrFailedAPICall,
+			retCode:  400,
+			wantErr:  errors.ErrFailedAPICall,
+			wantBody: `{"data":{"kind":"","content":"my data"},"recipient":"*"}`,
 		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var gotCalled bool
 			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotCalled = true
+				require.Equal(t, "POST", r.Method)
+				require.Equal(t, "/rooms/some-room/send-app-message", r.URL.Path)
+
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.True(t, json.Valid(body))
+				require.JSONEq(t, tc.wantBody, string(body))
+
 				w.WriteHeader(tc.retCode)
-				_, err := w.Write([]byte(tc.retBody))
+				_, err = w.Write([]byte(tc.retBody))
 				require.NoError(t, err)
 			}))
-			p := tc.params
+			defer testServer.Close()
+
 			creds := auth.Creds{
 				APIKey: "somekey",
 				APIURL: testServer.URL,
 			}
-			gotErr := room.SendAppMessage(creds, p)
+			gotErr := room.SendAppMessage(context.Background(), creds, "some-room", tc.payload, tc.opts)
 			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantBody != "", gotCalled)
 		})
 	}
 }
@@ -56,6 +56,58 @@ func TestCreate(t *testing.T) {
 				return gotTime
 			},
 		},
+		{
+			name:    "success with dial-in and SIP config",
+			params:  room.CreateParams{},
+			retCode: 200,
+			retBody: `
+				{
+				  "id": "987b5eb5-d116-4a4e-8e2c-14fcb5710966",
+				  "name": "phone-room",
+				  "api_created": true,
+				  "privacy":"public",
+				  "url":"https://api-demo.daily.co/phone-room",
+				  "created_at":"2019-01-26T09:01:22.000Z",
+				  "config":{
+					"enable_dialout": true,
+					"dialin": {
+					  "display_name": "Phone caller",
+					  "wait_for_meeting_start": true
+					},
+					"sip": {
+					  "display_name": "SIP caller",
+					  "video": true,
+					  "sip_mode": "dial-in",
+					  "num_endpoints": 2
+					}
+				  }
+				}
+        `,
+			wantRoom: room.Room{
+				ID:      "987b5eb5-d116-4a4e-8e2c-14fcb5710966",
+				Name:    "phone-room",
+				Url:     "https://api-demo.daily.co/phone-room",
+				Privacy: room.PrivacyPublic,
+				Config: room.Props{
+					EnableDialout: true,
+					Dialin: &room.DialinProps{
+						DisplayName:         "Phone caller",
+						WaitForMeetingStart: true,
+					},
+					SIP: &room.SIPProps{
+						DisplayName:  "SIP caller",
+						Video:        true,
+						SIPMode:      room.SIPModeDialIn,
+						NumEndpoints: 2,
+					},
+				},
+			},
+			getWantedCreatedAt: func() time.Time {
+				gotTime, gotErr := time.Parse(time.RFC3339, "2019-01-26T09:01:22.000Z")
+				require.NoError(t, gotErr)
+				return gotTime
+			},
+		},
 		{
 			name:    "failure",
 			params:  room.CreateParams{},
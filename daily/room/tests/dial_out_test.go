@@ -0,0 +1,192 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/room"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStartDialOut(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name          string
+		opts          room.StartDialOutOpts
+		retCode       int
+		retBody       string
+		wantBody      string
+		wantSessionID string
+		wantErr       error
+		wantCalled    bool
+	}{
+		{
+			name: "phone number",
+			opts: room.StartDialOutOpts{
+				PhoneNumber: "+12025550123",
+				DisplayName: "Bob",
+				CallerID:    "0f2b1c5e-8c1d-4f6a-9d0e-3b7a2c4d5e6f",
+			},
+			retCode:       http.StatusOK,
+			retBody:       `{"sessionId":"d61cd7b2-a273-42b4-89bd-be763fd562c1"}`,
+			wantBody:      `{"phoneNumber":"+12025550123","displayName":"Bob","callerId":"0f2b1c5e-8c1d-4f6a-9d0e-3b7a2c4d5e6f"}`,
+			wantSessionID: "d61cd7b2-a273-42b4-89bd-be763fd562c1",
+			wantCalled:    true,
+		},
+		{
+			name: "SIP URI with video",
+			opts: room.StartDialOutOpts{
+				SIPURI: "sip:bob@example.com",
+				Video:  true,
+				UserID: "user-1",
+			},
+			retCode:       http.StatusOK,
+			retBody:       `{"sessionId":"5e3cf703-5547-47d6-a371-37b1f0b4427f"}`,
+			wantBody:      `{"sipUri":"sip:bob@example.com","video":true,"userId":"user-1"}`,
+			wantSessionID: "5e3cf703-5547-47d6-a371-37b1f0b4427f",
+			wantCalled:    true,
+		},
+		{
+			name:    "no destination",
+			opts:    room.StartDialOutOpts{},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "phone number and SIP URI",
+			opts: room.StartDialOutOpts{
+				PhoneNumber: "+12025550123",
+				SIPURI:      "sip:bob@example.com",
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "phone number not in E.164 format",
+			opts: room.StartDialOutOpts{
+				PhoneNumber: "202-555-0123",
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "video with phone number",
+			opts: room.StartDialOutOpts{
+				PhoneNumber: "+12025550123",
+				Video:       true,
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "caller ID with SIP URI",
+			opts: room.StartDialOutOpts{
+				SIPURI:   "sip:bob@example.com",
+				CallerID: "0f2b1c5e-8c1d-4f6a-9d0e-3b7a2c4d5e6f",
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "invalid SIP URI",
+			opts: room.StartDialOutOpts{
+				SIPURI: "https://example.com",
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "bad status code",
+			opts: room.StartDialOutOpts{
+				SIPURI: "sips:bob@example.com",
+			},
+			retCode:    http.StatusBadRequest,
+			wantBody:   `{"sipUri":"sips:bob@example.com"}`,
+			wantErr:    errors.ErrFailedAPICall,
+			wantCalled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var gotCalled bool
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotCalled = true
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, "/rooms/some-room/dialOut/start", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantBody, string(body))
+				w.WriteHeader(tc.retCode)
+				_, err = w.Write([]byte(tc.retBody))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			gotSessionID, gotErr := room.StartDialOut(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-room", tc.opts)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantSessionID, gotSessionID)
+			require.Equal(t, tc.wantCalled, gotCalled)
+		})
+	}
+}
+
+func TestStopDialOut(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name       string
+		sessionID  string
+		retCode    int
+		wantBody   string
+		wantErr    error
+		wantCalled bool
+	}{
+		{
+			name:       "success",
+			sessionID:  "d61cd7b2-a273-42b4-89bd-be763fd562c1",
+			retCode:    http.StatusOK,
+			wantBody:   `{"sessionId":"d61cd7b2-a273-42b4-89bd-be763fd562c1"}`,
+			wantCalled: true,
+		},
+		{
+			name:    "no session ID",
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name:       "bad status code",
+			sessionID:  "d61cd7b2-a273-42b4-89bd-be763fd562c1",
+			retCode:    http.StatusNotFound,
+			wantBody:   `{"sessionId":"d61cd7b2-a273-42b4-89bd-be763fd562c1"}`,
+			wantErr:    errors.ErrFailedAPICall,
+			wantCalled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var gotCalled bool
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotCalled = true
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, "/rooms/some-room/dialOut/stop", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantBody, string(body))
+				w.WriteHeader(tc.retCode)
+			}))
+			defer testServer.Close()
+
+			gotErr := room.StopDialOut(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-room", tc.sessionID)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantCalled, gotCalled)
+		})
+	}
+}
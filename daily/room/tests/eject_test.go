@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/room"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEject(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name       string
+		params     room.EjectParams
+		retCode    int
+		retBody    string
+		wantBody   string
+		wantIDs    []string
+		wantErr    error
+		wantCalled bool
+	}{
+		{
+			name: "by session and user ID",
+			params: room.EjectParams{
+				IDs:     []string{"d61cd7b2-a273-42b4-89bd-be763fd562c1"},
+				UserIDs: []string{"user-1"},
+			},
+			retCode:    http.StatusOK,
+			retBody:    `{"ejectedIds":["d61cd7b2-a273-42b4-89bd-be763fd562c1","5e3cf703-5547-47d6-a371-37b1f0b4427f"]}`,
+			wantBody:   `{"ids":["d61cd7b2-a273-42b4-89bd-be763fd562c1"],"user_ids":["user-1"]}`,
+			wantIDs:    []string{"d61cd7b2-a273-42b4-89bd-be763fd562c1", "5e3cf703-5547-47d6-a371-37b1f0b4427f"},
+			wantCalled: true,
+		},
+		{
+			name:    "nobody to eject",
+			params:  room.EjectParams{},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "bad status code",
+			params: room.EjectParams{
+				UserIDs: []string{"user-1"},
+			},
+			retCode:    http.StatusNotFound,
+			wantBody:   `{"user_ids":["user-1"]}`,
+			wantErr:    errors.ErrFailedAPICall,
+			wantCalled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var gotCalled bool
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotCalled = true
+				require.Equal(t, "/rooms/some-room/eject", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantBody, string(body))
+				w.WriteHeader(tc.retCode)
+				_, err = w.Write([]byte(tc.retBody))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			gotIDs, gotErr := room.Eject(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-room", tc.params)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantIDs, gotIDs)
+			require.Equal(t, tc.wantCalled, gotCalled)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/room"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStartLiveStreaming(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name     string
+		opts     room.StartLiveStreamingOpts
+		retCode  int
+		wantErr  error
+		wantBody string
+	}{
+		{
+			name: "multiple outputs",
+			opts: room.StartLiveStreamingOpts{
+				RTMPURLs: []string{
+					"rtmps://live.example.com:443/app/stream-key",
+					"rtmp://a.rtmp.example.com/live2/other-key",
+				},
+				Layout: &room.Layout{
+					Preset: room.LayoutPresetActiveParticipant,
+				},
+				Resolution: &room.Resolution{
+					Width:  1920,
+					Height: 1080,
+				},
+				VideoBitrate: 5000,
+				InstanceID:   "c3df927c-f738-4471-a2b7-066fa7e95a6b",
+			},
+			retCode: http.StatusOK,
+			wantBody: `{
+				"rtmpUrl": ["rtmps://live.example.com:443/app/stream-key", "rtmp://a.rtmp.example.com/live2/other-key"],
+				"layout": {"preset": "active-participant"},
+				"width": 1920,
+				"height": 1080,
+				"videoBitrate": 5000,
+				"instanceId": "c3df927c-f738-4471-a2b7-066fa7e95a6b"
+			}`,
+		},
+		{
+			name:    "no outputs",
+			opts:    room.StartLiveStreamingOpts{},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "wrong scheme",
+			opts: room.StartLiveStreamingOpts{
+				RTMPURLs: []string{"https://live.example.com/app/stream-key"},
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "no host",
+			opts: room.StartLiveStreamingOpts{
+				RTMPURLs: []string{"rtmp:///app/stream-key"},
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "bad status code",
+			opts: room.StartLiveStreamingOpts{
+				RTMPURLs: []string{"rtmp://live.example.com/app/stream-key"},
+			},
+			retCode:  http.StatusBadRequest,
+			wantErr:  errors.ErrFailedAPICall,
+			wantBody: `{"rtmpUrl": ["rtmp://live.example.com/app/stream-key"]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/rooms/some-room/live-streaming/start", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantBody, string(body))
+				w.WriteHeader(tc.retCode)
+			}))
+			defer testServer.Close()
+
+			gotErr := room.StartLiveStreaming(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-room", tc.opts)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+		})
+	}
+}
+
+func TestStopAndUpdateLiveStreaming(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name     string
+		do       func(creds auth.Creds) error
+		wantPath string
+		wantBody string
+	}{
+		{
+			name: "stop",
+			do: func(creds auth.Creds) error {
+				return room.StopLiveStreaming(context.Background(), creds, "some-room", nil)
+			},
+			wantPath: "/rooms/some-room/live-streaming/stop",
+			wantBody: `{}`,
+		},
+		{
+			name: "update",
+			do: func(creds auth.Creds) error {
+				return room.UpdateLiveStreaming(context.Background(), creds, "some-room", room.UpdateLiveStreamingOpts{
+					Layout: room.Layout{
+						Preset: room.LayoutPresetPortrait,
+					},
+					InstanceID: "c3df927c-f738-4471-a2b7-066fa7e95a6b",
+				})
+			},
+			wantPath: "/rooms/some-room/live-streaming/update",
+			wantBody: `{"layout":{"preset":"portrait"},"instanceId":"c3df927c-f738-4471-a2b7-066fa7e95a6b"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, tc.wantPath, r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantBody, string(body))
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer testServer.Close()
+
+			require.NoError(t, tc.do(auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}))
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/room"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdatePermissions(t *testing.T) {
+	t.Parallel()
+	yes, no := true, false
+	testCases := []struct {
+		name     string
+		perms    map[string]room.Permissions
+		wantBody string
+		wantErr  error
+	}{
+		{
+			name: "promote one and restrict everyone",
+			perms: map[string]room.Permissions{
+				"d61cd7b2-a273-42b4-89bd-be763fd562c1": {
+					CanSend: &room.SendPermission{
+						Media: []room.MediaType{room.MediaVideo, room.MediaAudio, room.MediaScreenVideo},
+					},
+					CanAdmin: &room.AdminPermission{
+						Roles: []room.AdminRole{room.AdminParticipants},
+					},
+				},
+				room.AllParticipants: {
+					HasPresence: &no,
+					CanSend:     &room.SendPermission{All: false},
+				},
+			},
+			wantBody: `{"data":{
+				"d61cd7b2-a273-42b4-89bd-be763fd562c1": {
+					"canSend": ["video", "audio", "screenVideo"],
+					"canAdmin": ["participants"]
+				},
+				"*": {"hasPresence": false, "canSend": false}
+			}}`,
+		},
+		{
+			name: "full admin",
+			perms: map[string]room.Permissions{
+				"d61cd7b2-a273-42b4-89bd-be763fd562c1": {
+					HasPresence: &yes,
+					CanAdmin:    &room.AdminPermission{All: true},
+				},
+			},
+			wantBody: `{"data":{"d61cd7b2-a273-42b4-89bd-be763fd562c1":{"hasPresence":true,"canAdmin":true}}}`,
+		},
+		{
+			name:    "no participants",
+			perms:   map[string]room.Permissions{},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "no permissions",
+			perms: map[string]room.Permissions{
+				"d61cd7b2-a273-42b4-89bd-be763fd562c1": {},
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "unknown media",
+			perms: map[string]room.Permissions{
+				"*": {CanSend: &room.SendPermission{Media: []room.MediaType{"hologram"}}},
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "unknown role",
+			perms: map[string]room.Permissions{
+				"*": {CanAdmin: &room.AdminPermission{Roles: []room.AdminRole{"owner"}}},
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/rooms/some-room/update-permissions", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantBody, string(body))
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer testServer.Close()
+
+			gotErr := room.UpdatePermissions(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-room", tc.perms)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+		})
+	}
+}
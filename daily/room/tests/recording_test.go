@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/room"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestStartRecording(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name     string
+		opts     *room.StartRecordingOpts
+		retCode  int
+		wantErr  error
+		wantBody string
+	}{
+		{
+			name:     "no opts",
+			retCode:  http.StatusOK,
+			wantBody: `{}`,
+		},
+		{
+			name: "all opts",
+			opts: &room.StartRecordingOpts{
+				Type: room.RecordingTypeCloud,
+				Layout: &room.Layout{
+					Preset:        room.LayoutPresetDefault,
+					MaxCamStreams: 4,
+				},
+				MaxDuration: time.Hour,
+				Resolution: &room.Resolution{
+					Width:  1280,
+					Height: 720,
+				},
+				InstanceID: "c3df927c-f738-4471-a2b7-066fa7e95a6b",
+			},
+			retCode: http.StatusOK,
+			wantBody: `{
+				"type": "cloud",
+				"layout": {"preset": "default", "max_cam_streams": 4},
+				"maxDuration": 3600,
+				"width": 1280,
+				"height": 720,
+				"instanceId": "c3df927c-f738-4471-a2b7-066fa7e95a6b"
+			}`,
+		},
+		{
+			name: "unknown type",
+			opts: &room.StartRecordingOpts{
+				Type: "local",
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "single participant without session",
+			opts: &room.StartRecordingOpts{
+				Layout: &room.Layout{
+					Preset: room.LayoutPresetSingleParticipant,
+				},
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "bad resolution",
+			opts: &room.StartRecordingOpts{
+				Resolution: &room.Resolution{Width: 1280},
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name:     "bad status code",
+			retCode:  http.StatusBadRequest,
+			wantErr:  errors.ErrFailedAPICall,
+			wantBody: `{}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "POST", r.Method)
+				require.Equal(t, "/rooms/some-room/recordings/start", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantBody, string(body))
+				w.WriteHeader(tc.retCode)
+			}))
+			defer testServer.Close()
+
+			gotErr := room.StartRecording(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-room", tc.opts)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+		})
+	}
+}
+
+func TestStopAndUpdateRecording(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name     string
+		do       func(creds auth.Creds) error
+		wantPath string
+		wantBody string
+		wantErr  error
+	}{
+		{
+			name: "stop",
+			do: func(creds auth.Creds) error {
+				return room.StopRecording(context.Background(), creds, "some-room", &room.StopRecordingOpts{
+					Type:       room.RecordingTypeRawTracks,
+					InstanceID: "c3df927c-f738-4471-a2b7-066fa7e95a6b",
+				})
+			},
+			wantPath: "/rooms/some-room/recordings/stop",
+			wantBody: `{"type":"raw-tracks","instanceId":"c3df927c-f738-4471-a2b7-066fa7e95a6b"}`,
+		},
+		{
+			name: "update",
+			do: func(creds auth.Creds) error {
+				return room.UpdateRecording(context.Background(), creds, "some-room", room.UpdateRecordingOpts{
+					Layout: room.Layout{
+						Preset:    room.LayoutPresetSingleParticipant,
+						SessionID: "2c2b8b14-1f2c-4cb4-8e1c-6b4a6b4b8c3e",
+					},
+				})
+			},
+			wantPath: "/rooms/some-room/recordings/update",
+			wantBody: `{"layout":{"preset":"single-participant","session_id":"2c2b8b14-1f2c-4cb4-8e1c-6b4a6b4b8c3e"}}`,
+		},
+		{
+			name: "update with unknown preset",
+			do: func(creds auth.Creds) error {
+				return room.UpdateRecording(context.Background(), creds, "some-room", room.UpdateRecordingOpts{
+					Layout: room.Layout{
+						Preset: "grid",
+					},
+				})
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, tc.wantPath, r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantBody, string(body))
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer testServer.Close()
+
+			gotErr := tc.do(auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			})
+			require.ErrorIs(t, gotErr, tc.wantErr)
+		})
+	}
+}
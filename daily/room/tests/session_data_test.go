@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/room"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type whiteboard struct {
+	Owner   string   `json:"owner"`
+	Strokes []string `json:"strokes"`
+}
+
+func TestGetSessionData(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name     string
+		retCode  int
+		retBody  string
+		wantData whiteboard
+		wantErr  error
+	}{
+		{
+			name:    "data retrieved",
+			retCode: http.StatusOK,
+			retBody: `{"data":{"owner":"alice","strokes":["a","b"]}}`,
+			wantData: whiteboard{
+				Owner:   "alice",
+				Strokes: []string{"a", "b"},
+			},
+		},
+		{
+			name:    "no data set",
+			retCode: http.StatusOK,
+			retBody: `{"data":null}`,
+		},
+		{
+			name:    "data of other type",
+			retCode: http.StatusOK,
+			retBody: `{"data":{"owner":1}}`,
+			wantErr: room.ErrFailUnmarshal,
+		},
+		{
+			name:    "bad status code",
+			retCode: http.StatusNotFound,
+			retBody: `{}`,
+			wantErr: errors.ErrFailedAPICall,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "GET", r.Method)
+				require.Equal(t, "/rooms/some-room/get-session-data", r.URL.Path)
+				w.WriteHeader(tc.retCode)
+				_, err := w.Write([]byte(tc.retBody))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			gotData, gotErr := room.GetSessionData[whiteboard](context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-room")
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			if gotErr == nil {
+				require.Equal(t, tc.wantData, gotData)
+			}
+		})
+	}
+}
+
+func TestSetSessionData(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name       string
+		set        func(ctx context.Context, creds auth.Creds) error
+		wantBody   string
+		wantErr    error
+		wantCalled bool
+	}{
+		{
+			name: "replace",
+			set: func(ctx context.Context, creds auth.Creds) error {
+				return room.SetSessionData(ctx, creds, "some-room", whiteboard{Owner: "alice"}, nil)
+			},
+			wantBody:   `{"data":{"owner":"alice","strokes":null}}`,
+			wantCalled: true,
+		},
+		{
+			name: "shallow merge",
+			set: func(ctx context.Context, creds auth.Creds) error {
+				return room.SetSessionData(ctx, creds, "some-room", map[string]int{"count": 2}, &room.SetSessionDataOpts{
+					MergeStrategy: room.MergeShallow,
+				})
+			},
+			wantBody:   `{"data":{"count":2},"mergeStrategy":"shallow-merge"}`,
+			wantCalled: true,
+		},
+		{
+			name: "patch",
+			set: func(ctx context.Context, creds auth.Creds) error {
+				return room.PatchSessionData(ctx, creds, "some-room", map[string]interface{}{"owner": "bob"}, []string{"strokes"})
+			},
+			wantBody:   `{"data":{"owner":"bob"},"mergeStrategy":"shallow-merge","keysToDelete":["strokes"]}`,
+			wantCalled: true,
+		},
+		{
+			name: "patch deletes only",
+			set: func(ctx context.Context, creds auth.Creds) error {
+				return room.PatchSessionData(ctx, creds, "some-room", nil, []string{"strokes"})
+			},
+			wantBody:   `{"data":{},"mergeStrategy":"shallow-merge","keysToDelete":["strokes"]}`,
+			wantCalled: true,
+		},
+		{
+			name: "patch sets and deletes same key",
+			set: func(ctx context.Context, creds auth.Creds) error {
+				return room.PatchSessionData(ctx, creds, "some-room", map[string]interface{}{"owner": "bob"}, []string{"owner"})
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "not an object",
+			set: func(ctx context.Context, creds auth.Creds) error {
+				return room.SetSessionData(ctx, creds, "some-room", []string{"a"}, nil)
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "delete keys when replacing",
+			set: func(ctx context.Context, creds auth.Creds) error {
+				return room.SetSessionData(ctx, creds, "some-room", whiteboard{}, &room.SetSessionDataOpts{
+					KeysToDelete: []string{"owner"},
+				})
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+		{
+			name: "unknown merge strategy",
+			set: func(ctx context.Context, creds auth.Creds) error {
+				return room.SetSessionData(ctx, creds, "some-room", whiteboard{}, &room.SetSessionDataOpts{
+					MergeStrategy: "deep-merge",
+				})
+			},
+			wantErr: room.ErrInvalidOpts,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var gotCalled bool
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotCalled = true
+				require.Equal(t, "POST", r.Method)
+				require.Equal(t, "/rooms/some-room/set-session-data", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantBody, string(body))
+				_, err = w.Write([]byte(`{}`))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			gotErr := tc.set(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			})
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantCalled, gotCalled)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/room"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTranscription(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name     string
+		do       func(creds auth.Creds) error
+		wantPath string
+		wantBody string
+	}{
+		{
+			name: "start",
+			do: func(creds auth.Creds) error {
+				return room.StartTranscription(context.Background(), creds, "some-room", &room.StartTranscriptionOpts{
+					Language:  "fr",
+					Model:     "nova-2",
+					Punctuate: true,
+				})
+			},
+			wantPath: "/rooms/some-room/transcription/start",
+			wantBody: `{"language":"fr","model":"nova-2","punctuate":true}`,
+		},
+		{
+			name: "update",
+			do: func(creds auth.Creds) error {
+				return room.UpdateTranscription(context.Background(), creds, "some-room", room.UpdateTranscriptionOpts{
+					Participants: []string{"2c2b8b14-1f2c-4cb4-8e1c-6b4a6b4b8c3e"},
+				})
+			},
+			wantPath: "/rooms/some-room/transcription/update",
+			wantBody: `{"participants":["2c2b8b14-1f2c-4cb4-8e1c-6b4a6b4b8c3e"]}`,
+		},
+		{
+			name: "stop",
+			do: func(creds auth.Creds) error {
+				return room.StopTranscription(context.Background(), creds, "some-room", &room.StopTranscriptionOpts{
+					InstanceID: "c3df927c-f738-4471-a2b7-066fa7e95a6b",
+				})
+			},
+			wantPath: "/rooms/some-room/transcription/stop",
+			wantBody: `{"instanceId":"c3df927c-f738-4471-a2b7-066fa7e95a6b"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "POST", r.Method)
+				require.Equal(t, tc.wantPath, r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantBody, string(body))
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer testServer.Close()
+
+			require.NoError(t, tc.do(auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}))
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+)
+
+// StartTranscriptionOpts represents optional
+// parameters for starting a transcription.
+type StartTranscriptionOpts struct {
+	// Language is a BCP-47 language code, e.g. "en" or "fr"
+	Language string
+	// Model is the transcription model to use, e.g. "nova-2"
+	Model           string
+	ProfanityFilter bool
+	Punctuate       bool
+	// InstanceID identifies the transcription when multiple
+	// transcriptions of the same room are running at once.
+	InstanceID string
+}
+
+// UpdateTranscriptionOpts represents parameters
+// for updating an ongoing transcription.
+type UpdateTranscriptionOpts struct {
+	// Participants are the session IDs of the participants
+	// to transcribe. If empty, all participants are transcribed.
+	Participants []string
+	InstanceID   string
+}
+
+// StopTranscriptionOpts represents optional
+// parameters for stopping a transcription.
+type StopTranscriptionOpts struct {
+	InstanceID string
+}
+
+type startTranscriptionBody struct {
+	Language        string `json:"language,omitempty"`
+	Model           string `json:"model,omitempty"`
+	ProfanityFilter bool   `json:"profanity_filter,omitempty"`
+	Punctuate       bool   `json:"punctuate,omitempty"`
+	InstanceID      string `json:"instanceId,omitempty"`
+}
+
+type updateTranscriptionBody struct {
+	Participants []string `json:"participants,omitempty"`
+	InstanceID   string   `json:"instanceId,omitempty"`
+}
+
+type stopTranscriptionBody struct {
+	InstanceID string `json:"instanceId,omitempty"`
+}
+
+// StartTranscription starts transcribing the given room
+func StartTranscription(ctx context.Context, creds auth.Creds, roomName string, opts *StartTranscriptionOpts) error {
+	var body startTranscriptionBody
+	if opts != nil {
+		body = startTranscriptionBody{
+			Language:        opts.Language,
+			Model:           opts.Model,
+			ProfanityFilter: opts.ProfanityFilter,
+			Punctuate:       opts.Punctuate,
+			InstanceID:      opts.InstanceID,
+		}
+	}
+	if _, err := doRoomAction(ctx, creds, roomName, body, "transcription", "start"); err != nil {
+		return fmt.Errorf("failed to start transcription: %w", err)
+	}
+	return nil
+}
+
+// UpdateTranscription updates an ongoing transcription of the given room
+func UpdateTranscription(ctx context.Context, creds auth.Creds, roomName string, opts UpdateTranscriptionOpts) error {
+	body := updateTranscriptionBody{
+		Participants: opts.Participants,
+		InstanceID:   opts.InstanceID,
+	}
+	if _, err := doRoomAction(ctx, creds, roomName, body, "transcription", "update"); err != nil {
+		return fmt.Errorf("failed to update transcription: %w", err)
+	}
+	return nil
+}
+
+// StopTranscription stops a transcription of the given room
+func StopTranscription(ctx context.Context, creds auth.Creds, roomName string, opts *StopTranscriptionOpts) error {
+	var body stopTranscriptionBody
+	if opts != nil {
+		body.InstanceID = opts.InstanceID
+	}
+	if _, err := doRoomAction(ctx, creds, roomName, body, "transcription", "stop"); err != nil {
+		return fmt.Errorf("failed to stop transcription: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package daily
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/room"
+)
+
+// GetSessionData decodes the session data of the ongoing meeting in
+// the given room into v, which must be a pointer. To decode straight
+// into a type, use room.GetSessionData.
+func (d *Daily) GetSessionData(ctx context.Context, roomName string, v interface{}) error {
+	data, err := room.GetSessionData[json.RawMessage](ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode session data: %w", err)
+	}
+	return nil
+}
+
+// SetSessionData sets the session data of the ongoing
+// meeting in the given room
+func (d *Daily) SetSessionData(ctx context.Context, roomName string, data interface{}, opts *room.SetSessionDataOpts) error {
+	return room.SetSessionData(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, data, opts)
+}
+
+// PatchSessionData sets and deletes the given top-level keys of the
+// session data of the ongoing meeting in the given room
+func (d *Daily) PatchSessionData(ctx context.Context, roomName string, set map[string]interface{}, deleteKeys []string) error {
+	return room.PatchSessionData(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, set, deleteKeys)
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/room"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBroadcastAppMessage(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name        string
+		roomNames   []string
+		failRooms   []string
+		filter      string
+		payload     interface{}
+		concurrency int
+		wantResults []string
+		wantFailed  []string
+		wantErr     error
+	}{
+		{
+			name:        "matched rooms messaged",
+			roomNames:   []string{"team-a", "team-b", "other", "team-c"},
+			filter:      "^team-",
+			payload:     map[string]string{"msg": "hello"},
+			concurrency: 2,
+			wantResults: []string{"team-a", "team-b", "team-c"},
+		},
+		{
+			name:        "failure in one room does not stop the others",
+			roomNames:   []string{"team-a", "team-b", "team-c"},
+			failRooms:   []string{"team-b"},
+			filter:      "^team-",
+			payload:     map[string]string{"msg": "hello"},
+			concurrency: 1,
+			wantResults: []string{"team-a", "team-b", "team-c"},
+			wantFailed:  []string{"team-b"},
+		},
+		{
+			name:        "payload too large",
+			roomNames:   []string{"team-a", "team-b"},
+			filter:      "^team-",
+			payload:     map[string]string{"msg": strings.Repeat("a", room.MaxAppMessageSize)},
+			concurrency: 2,
+			wantErr:     room.ErrAppMessageTooLarge,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var inFlight, maxInFlight, messaged int32
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/rooms" {
+					var data []string
+					for i, n := range tc.roomNames {
+						data = append(data, fmt.Sprintf(`{"id":"room-%d","name":"%s"}`, i, n))
+					}
+					_, err := fmt.Fprintf(w, `{"total_count":%d,"data":[%s]}`, len(data), strings.Join(data, ","))
+					require.NoError(t, err)
+					return
+				}
+
+				require.Equal(t, http.MethodPost, r.Method)
+				require.True(t, strings.HasSuffix(r.URL.Path, "/send-app-message"))
+				atomic.AddInt32(&messaged, 1)
+				n := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					m := atomic.LoadInt32(&maxInFlight)
+					if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+						break
+					}
+				}
+				// Give other requests a chance to overlap
+				time.Sleep(10 * time.Millisecond)
+
+				for _, f := range tc.failRooms {
+					if r.URL.Path == "/rooms/"+f+"/send-app-message" {
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+				}
+				_, err := w.Write([]byte(`{"sent":true}`))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			d, err := daily.NewDaily("someKey")
+			require.NoError(t, err)
+			d.WithAPIURL(testServer.URL)
+			d.WithRoomFanoutConcurrency(tc.concurrency)
+
+			gotResults, gotErr := d.BroadcastAppMessage(context.Background(), regexp.MustCompile(tc.filter), tc.payload)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			if tc.wantErr != nil {
+				require.Zero(t, atomic.LoadInt32(&messaged))
+				return
+			}
+
+			var gotRooms, gotFailed []string
+			for _, res := range gotResults {
+				gotRooms = append(gotRooms, res.RoomName)
+				if res.Err != nil {
+					require.ErrorIs(t, res.Err, errors.ErrFailedAPICall)
+					gotFailed = append(gotFailed, res.RoomName)
+				}
+			}
+			require.Equal(t, tc.wantResults, gotRooms)
+			require.Equal(t, tc.wantFailed, gotFailed)
+			require.EqualValues(t, len(tc.wantResults), atomic.LoadInt32(&messaged))
+			require.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(tc.concurrency))
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEjectUserFromAllRooms(t *testing.T) {
+	t.Parallel()
+	const presenceBody = `{
+		"room-a": [{"id":"session-1","room":"room-a","userId":"user-1"}],
+		"room-b": [{"id":"session-2","room":"room-b","userId":"user-2"},{"id":"session-3","room":"room-b","userId":"user-1"}],
+		"room-c": [{"id":"session-4","room":"room-c","userId":"user-1"}],
+		"room-d": [{"id":"session-5","room":"room-d","userId":"user-1"}],
+		"room-e": [{"id":"session-6","room":"room-e","userId":"user-2"}]
+	}`
+	sessionIDs := map[string]string{
+		"room-a": "session-1",
+		"room-b": "session-3",
+		"room-c": "session-4",
+		"room-d": "session-5",
+	}
+
+	var inFlight, maxInFlight int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/presence" {
+			_, err := w.Write([]byte(presenceBody))
+			require.NoError(t, err)
+			return
+		}
+
+		require.Equal(t, http.MethodPost, r.Method)
+		roomName := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/rooms/"), "/eject")
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"user_ids":["user-1"]}`, string(body))
+
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		// Give other requests a chance to overlap
+		time.Sleep(10 * time.Millisecond)
+
+		if roomName == "room-c" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, err = w.Write([]byte(`{"ejectedIds":["` + sessionIDs[roomName] + `"]}`))
+		require.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	d, err := daily.NewDaily("someKey")
+	require.NoError(t, err)
+	d.WithAPIURL(testServer.URL)
+	d.WithRoomFanoutConcurrency(2)
+
+	gotResults, gotErr := d.EjectUserFromAllRooms(context.Background(), "user-1")
+	require.NoError(t, gotErr)
+	require.Len(t, gotResults, 4)
+
+	// Results are ordered by room name, and a failure in
+	// one room does not prevent ejecting from the others
+	for i, roomName := range []string{"room-a", "room-b", "room-c", "room-d"} {
+		res := gotResults[i]
+		require.Equal(t, roomName, res.RoomName)
+		if roomName == "room-c" {
+			require.ErrorIs(t, res.Err, errors.ErrFailedAPICall)
+			require.Empty(t, res.EjectedIDs)
+			continue
+		}
+		require.NoError(t, res.Err)
+		require.Equal(t, []string{sessionIDs[roomName]}, res.EjectedIDs)
+	}
+	require.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+}
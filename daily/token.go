@@ -0,0 +1,16 @@
+package daily
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/token"
+)
+
+// GetMeetingToken validates the given meeting token
+// with Daily and returns its claims
+func (d *Daily) GetMeetingToken(ctx context.Context, tok string) (*token.Claims, error) {
+	return token.Get(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, tok)
+}
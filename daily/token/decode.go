@@ -0,0 +1,56 @@
+package token
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// jwtClaimNames maps the abbreviated claim names used in
+// a meeting token's JWT payload to their property names
+var jwtClaimNames = map[string]string{
+	"r":  "room_name",
+	"u":  "user_name",
+	"ud": "user_id",
+	"o":  "is_owner",
+}
+
+// Decode returns the claims of the given meeting token without
+// verifying its signature, so it must not be used to decide whether
+// a token can be trusted. Use Get to validate a token with Daily.
+// Claims with abbreviated names which are not known are kept under
+// their JWT names in AdditionalProps.
+func Decode(tok string) (*Claims, error) {
+	parts := strings.Split(tok, ".")
+	if len(parts) != 3 {
+		return nil, NewErrInvalidToken(errors.New("token is not a JWT"))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, NewErrInvalidToken(fmt.Errorf("failed to decode token payload: %w", err))
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return nil, NewErrInvalidToken(fmt.Errorf("failed to unmarshal token payload: %w", err))
+	}
+	named := make(map[string]json.RawMessage, len(raw))
+	for k, v := range raw {
+		if name, ok := jwtClaimNames[k]; ok {
+			k = name
+		}
+		named[k] = v
+	}
+	namedBlob, err := json.Marshal(named)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal token claims: %w", err)
+	}
+
+	var claims Claims
+	if err := json.Unmarshal(namedBlob, &claims); err != nil {
+		return nil, NewErrInvalidToken(fmt.Errorf("failed to unmarshal token claims: %w", err))
+	}
+	return &claims, nil
+}
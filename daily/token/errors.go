@@ -0,0 +1,29 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrInvalidToken is returned when a meeting token is
+	// malformed or is not accepted by Daily.
+	ErrInvalidToken = errors.New("invalid meeting token")
+	// ErrExpiredToken is returned when a meeting
+	// token's expiry time has passed.
+	ErrExpiredToken  = errors.New("meeting token has expired")
+	ErrFailUnmarshal = errors.New("failed to unmarshal response body into meeting token claims")
+)
+
+func NewErrInvalidToken(err error) error {
+	return fmt.Errorf("%s: %w", err, ErrInvalidToken)
+}
+
+func NewErrExpiredToken(expiredAt time.Time) error {
+	return fmt.Errorf("expired at %s: %w", expiredAt.Format(time.RFC3339), ErrExpiredToken)
+}
+
+func NewErrFailUnmarshal(unmarshalErr error) error {
+	return fmt.Errorf("%s: %w", unmarshalErr, ErrFailUnmarshal)
+}
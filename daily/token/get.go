@@ -0,0 +1,70 @@
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	errors2 "github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Get validates the given meeting token with Daily and returns its
+// claims. ErrInvalidToken is returned if Daily does not accept the
+// token, and ErrExpiredToken if the token has expired.
+func Get(ctx context.Context, creds auth.Creds, tok string) (*Claims, error) {
+	if tok == "" {
+		return nil, NewErrInvalidToken(errors.New("token is empty"))
+	}
+	endpoint, err := tokensEndpoint(creds.APIURL, tok)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request to meeting tokens endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate meeting token: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors2.NewErrFailedBodyRead(err)
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusBadRequest, http.StatusNotFound:
+		// Daily does not say why a token was rejected, so
+		// check whether it is a well-formed one which expired
+		if claims, err := Decode(tok); err == nil && claims.IsExpired(time.Now()) {
+			return nil, NewErrExpiredToken(claims.GetExpiry())
+		}
+		return nil, NewErrInvalidToken(errors2.NewErrFailedAPICall(res.StatusCode, string(resBody)))
+	default:
+		return nil, errors2.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+
+	var claims Claims
+	if err := json.Unmarshal(resBody, &claims); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	if claims.IsExpired(time.Now()) {
+		return nil, NewErrExpiredToken(claims.GetExpiry())
+	}
+	return &claims, nil
+}
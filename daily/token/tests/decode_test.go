@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"encoding/base64"
+	"github.com/lazeratops/daily-go/daily/token"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name       string
+		token      string
+		wantClaims *token.Claims
+		wantErr    error
+	}{
+		{
+			name:  "abbreviated claims",
+			token: makeJWT(`{"r":"some-room","u":"Alice","ud":"user-1","o":true,"exp":1700000000,"nbf":1690000000,"d":"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d","iat":1690000000}`),
+			wantClaims: &token.Claims{
+				RoomName: "some-room",
+				UserName: "Alice",
+				UserID:   "user-1",
+				IsOwner:  true,
+				Exp:      1700000000,
+				Nbf:      1690000000,
+				AdditionalProps: map[string]interface{}{
+					"d":   "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+					"iat": float64(1690000000),
+				},
+			},
+		},
+		{
+			name:  "full claim names",
+			token: makeJWT(`{"room_name":"some-room","start_video_off":true,"enable_recording":"cloud"}`),
+			wantClaims: &token.Claims{
+				RoomName:        "some-room",
+				StartVideoOff:   true,
+				EnableRecording: "cloud",
+			},
+		},
+		{
+			name:    "not a JWT",
+			token:   "not-a-token",
+			wantErr: token.ErrInvalidToken,
+		},
+		{
+			name:    "payload not base64",
+			token:   "eyJhbGciOiJIUzI1NiJ9.!!!.c2ln",
+			wantErr: token.ErrInvalidToken,
+		},
+		{
+			name:    "payload not JSON",
+			token:   makeJWT(`not json`),
+			wantErr: token.ErrInvalidToken,
+		},
+		{
+			name:    "claim of wrong type",
+			token:   makeJWT(`{"r":42}`),
+			wantErr: token.ErrInvalidToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			gotClaims, gotErr := token.Decode(tc.token)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantClaims, gotClaims)
+		})
+	}
+}
+
+func TestIsExpired(t *testing.T) {
+	t.Parallel()
+	now := time.Unix(1700000000, 0)
+	require.False(t, (&token.Claims{}).IsExpired(now))
+	require.False(t, (&token.Claims{Exp: now.Unix() + 1}).IsExpired(now))
+	require.True(t, (&token.Claims{Exp: now.Unix()}).IsExpired(now))
+	require.True(t, (&token.Claims{Exp: now.Unix() - 1}).IsExpired(now))
+}
+
+func TestFromJoinLink(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name      string
+		link      string
+		wantToken string
+		wantErr   error
+	}{
+		{
+			name:      "link with token",
+			link:      "https://your-domain.daily.co/some-room?t=eyJhbGciOiJIUzI1NiJ9.e30.c2ln",
+			wantToken: "eyJhbGciOiJIUzI1NiJ9.e30.c2ln",
+		},
+		{
+			name:    "link without token",
+			link:    "https://your-domain.daily.co/some-room",
+			wantErr: token.ErrInvalidToken,
+		},
+		{
+			name:    "invalid link",
+			link:    "https://your-domain.daily.co/%zz",
+			wantErr: token.ErrInvalidToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			gotToken, gotErr := token.FromJoinLink(tc.link)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantToken, gotToken)
+		})
+	}
+}
+
+// makeJWT returns an unsigned JWT with the given payload
+func makeJWT(payload string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + enc.EncodeToString([]byte(payload)) + ".c2lnbmF0dXJl"
+}
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/token"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+	future := time.Now().Add(time.Hour).Unix()
+	past := time.Now().Add(-time.Hour).Unix()
+	validToken := makeJWT(fmt.Sprintf(`{"r":"some-room","exp":%d}`, future))
+	expiredToken := makeJWT(fmt.Sprintf(`{"r":"some-room","exp":%d}`, past))
+
+	testCases := []struct {
+		name       string
+		token      string
+		retCode    int
+		retBody    string
+		wantClaims *token.Claims
+		wantErr    error
+		wantCalled bool
+	}{
+		{
+			name:    "valid token",
+			token:   validToken,
+			retCode: http.StatusOK,
+			retBody: fmt.Sprintf(`{"room_name":"some-room","is_owner":true,"user_name":"Alice","exp":%d,"lang":"de"}`, future),
+			wantClaims: &token.Claims{
+				RoomName: "some-room",
+				IsOwner:  true,
+				UserName: "Alice",
+				Exp:      future,
+				AdditionalProps: map[string]interface{}{
+					"lang": "de",
+				},
+			},
+			wantCalled: true,
+		},
+		{
+			name:       "expired token accepted by Daily",
+			token:      expiredToken,
+			retCode:    http.StatusOK,
+			retBody:    fmt.Sprintf(`{"room_name":"some-room","exp":%d}`, past),
+			wantErr:    token.ErrExpiredToken,
+			wantCalled: true,
+		},
+		{
+			name:       "expired token rejected by Daily",
+			token:      expiredToken,
+			retCode:    http.StatusBadRequest,
+			retBody:    `{"error":"invalid-request-error","info":"invalid token"}`,
+			wantErr:    token.ErrExpiredToken,
+			wantCalled: true,
+		},
+		{
+			name:       "invalid token",
+			token:      "not-a-token",
+			retCode:    http.StatusNotFound,
+			retBody:    `{"error":"not-found"}`,
+			wantErr:    token.ErrInvalidToken,
+			wantCalled: true,
+		},
+		{
+			name:    "empty token",
+			wantErr: token.ErrInvalidToken,
+		},
+		{
+			name:       "bad API key",
+			token:      validToken,
+			retCode:    http.StatusUnauthorized,
+			retBody:    `{"error":"authorization-header-error"}`,
+			wantErr:    errors.ErrFailedAPICall,
+			wantCalled: true,
+		},
+		{
+			name:       "bad body",
+			token:      validToken,
+			retCode:    http.StatusOK,
+			retBody:    `{"room_name":42}`,
+			wantErr:    token.ErrFailUnmarshal,
+			wantCalled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var gotCalled bool
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotCalled = true
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "/meeting-tokens/"+tc.token, r.URL.Path)
+				w.WriteHeader(tc.retCode)
+				_, err := w.Write([]byte(tc.retBody))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			gotClaims, gotErr := token.Get(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, tc.token)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantClaims, gotClaims)
+			require.Equal(t, tc.wantCalled, gotCalled)
+		})
+	}
+}
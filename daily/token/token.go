@@ -0,0 +1,119 @@
+// Package token handles Daily meeting tokens
+package token
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"net/url"
+	"path"
+	"time"
+)
+
+// Claims represents the properties of a Daily meeting token
+type Claims struct {
+	RoomName string `json:"room_name,omitempty"`
+	UserName string `json:"user_name,omitempty"`
+	UserID   string `json:"user_id,omitempty"`
+	IsOwner  bool   `json:"is_owner,omitempty"`
+	// Exp is the Unix timestamp after which the
+	// token can no longer be used to join
+	Exp int64 `json:"exp,omitempty"`
+	// Nbf is the Unix timestamp before which the
+	// token cannot be used to join
+	Nbf             int64  `json:"nbf,omitempty"`
+	EjectAtTokenExp bool   `json:"eject_at_token_exp,omitempty"`
+	EnableRecording string `json:"enable_recording,omitempty"`
+	StartVideoOff   bool   `json:"start_video_off,omitempty"`
+	StartAudioOff   bool   `json:"start_audio_off,omitempty"`
+	AdditionalProps map[string]interface{}
+}
+
+func GetClaimsKeys() []string {
+	return []string{"room_name", "user_name", "user_id", "is_owner", "exp", "nbf", "eject_at_token_exp", "enable_recording", "start_video_off", "start_audio_off"}
+}
+
+func (c *Claims) UnmarshalJSON(data []byte) error {
+	// Alias Claims to unmarshal the typed claims
+	// without recursing into this method
+	type claims Claims
+	var cl claims
+	if err := json.Unmarshal(data, &cl); err != nil {
+		return err
+	}
+	*c = Claims(cl)
+	c.AdditionalProps = nil
+
+	// Check claims that are not in Claims
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("failed to unmarshal body to map: %w", err)
+	}
+	claimsKeys := GetClaimsKeys()
+	for k, v := range m {
+		if !isInSlice(k, claimsKeys) {
+			if c.AdditionalProps == nil {
+				c.AdditionalProps = make(map[string]interface{})
+			}
+			c.AdditionalProps[k] = v
+		}
+	}
+	return nil
+}
+
+// GetExpiry returns the time after which the token can no
+// longer be used to join, or the zero time if it never expires
+func (c *Claims) GetExpiry() time.Time {
+	if c.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(c.Exp, 0)
+}
+
+// GetNotBefore returns the time before which the token cannot
+// be used to join, or the zero time if it can be used right away
+func (c *Claims) GetNotBefore() time.Time {
+	if c.Nbf == 0 {
+		return time.Time{}
+	}
+	return time.Unix(c.Nbf, 0)
+}
+
+// IsExpired reports whether the token has expired at the given time
+func (c *Claims) IsExpired(now time.Time) bool {
+	return c.Exp != 0 && !now.Before(c.GetExpiry())
+}
+
+// FromJoinLink returns the meeting token in the given join
+// link, e.g. "https://your-domain.daily.co/room?t=<token>"
+func FromJoinLink(link string) (string, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", NewErrInvalidToken(fmt.Errorf("invalid join link '%s': %w", link, err))
+	}
+	t := u.Query().Get("t")
+	if t == "" {
+		return "", NewErrInvalidToken(fmt.Errorf("join link has no token: '%s'", link))
+	}
+	return t, nil
+}
+
+func isInSlice(ele string, s []string) bool {
+	for _, k := range s {
+		if k == ele {
+			return true
+		}
+	}
+	return false
+}
+
+func tokensEndpoint(apiURL string, paths ...string) (string, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return "", errors.NewErrFailedEndpointConstruction(err)
+	}
+
+	allPaths := append([]string{u.Path, "meeting-tokens"}, paths...)
+	u.Path = path.Join(allPaths...)
+	return u.String(), nil
+}
@@ -0,0 +1,77 @@
+package daily
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/room"
+	"github.com/lazeratops/daily-go/daily/transcript"
+)
+
+// StartTranscription starts transcribing the given Daily room
+func (d *Daily) StartTranscription(ctx context.Context, roomName string, opts *room.StartTranscriptionOpts) error {
+	return room.StartTranscription(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, opts)
+}
+
+// UpdateTranscription updates an ongoing transcription of the given Daily room
+func (d *Daily) UpdateTranscription(ctx context.Context, roomName string, opts room.UpdateTranscriptionOpts) error {
+	return room.UpdateTranscription(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, opts)
+}
+
+// StopTranscription stops a transcription of the given Daily room
+func (d *Daily) StopTranscription(ctx context.Context, roomName string, opts *room.StopTranscriptionOpts) error {
+	return room.StopTranscription(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, roomName, opts)
+}
+
+// GetTranscripts returns multiple Daily transcripts matching
+// the given limits, if any
+func (d *Daily) GetTranscripts(ctx context.Context, params *transcript.GetManyParams) ([]transcript.Transcript, error) {
+	return transcript.GetMany(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, params)
+}
+
+// GetTranscript returns the metadata of a single Daily
+// transcript matching the given ID
+func (d *Daily) GetTranscript(ctx context.Context, transcriptID string) (*transcript.Transcript, error) {
+	return transcript.GetOne(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, transcriptID)
+}
+
+// DeleteTranscript deletes the given Daily transcript
+func (d *Daily) DeleteTranscript(ctx context.Context, transcriptID string) error {
+	return transcript.Delete(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, transcriptID)
+}
+
+// GetTranscriptAccessLink generates a download link for
+// the WebVTT content of the given Daily transcript
+func (d *Daily) GetTranscriptAccessLink(ctx context.Context, transcriptID string) (*transcript.AccessLink, error) {
+	return transcript.GetAccessLink(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, transcriptID)
+}
+
+// GetTranscriptCues retrieves and parses the WebVTT
+// content of the given Daily transcript
+func (d *Daily) GetTranscriptCues(ctx context.Context, transcriptID string) ([]transcript.Cue, error) {
+	link, err := d.GetTranscriptAccessLink(ctx, transcriptID)
+	if err != nil {
+		return nil, err
+	}
+	return transcript.FetchCues(ctx, link.Link)
+}
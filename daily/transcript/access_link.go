@@ -0,0 +1,59 @@
+package transcript
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+)
+
+// AccessLink is a temporary link to download
+// the WebVTT content of a transcript
+type AccessLink struct {
+	TranscriptID string `json:"transcriptId"`
+	Link         string `json:"link"`
+}
+
+// GetAccessLink generates a download link for the
+// WebVTT content of the transcript with the given ID
+func GetAccessLink(ctx context.Context, creds auth.Creds, transcriptID string) (*AccessLink, error) {
+	endpoint, err := transcriptsEndpoint(creds.APIURL, transcriptID, "access-link")
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request to access link endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transcript access link: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+
+	var link AccessLink
+	if err := json.Unmarshal(resBody, &link); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &link, nil
+}
@@ -0,0 +1,135 @@
+package transcript
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Format is an output format transcripts can be converted to
+type Format string
+
+const (
+	// FormatSRT is the SubRip subtitle format
+	FormatSRT Format = "srt"
+	// FormatText is readable plain text, one paragraph per speaker turn
+	FormatText Format = "txt"
+	// FormatJSON is JSON Lines, one cue per line
+	FormatJSON Format = "json"
+)
+
+// jsonCue is the JSON Lines representation of a cue,
+// with timestamps in seconds
+type jsonCue struct {
+	ID      string  `json:"id,omitempty"`
+	Start   float64 `json:"start"`
+	End     float64 `json:"end"`
+	Speaker string  `json:"speaker,omitempty"`
+	Text    string  `json:"text"`
+}
+
+// Export writes the given cues to w in the given format
+func Export(w io.Writer, cues []Cue, format Format) error {
+	switch format {
+	case FormatSRT:
+		return WriteSRT(w, cues)
+	case FormatText:
+		return WriteText(w, cues)
+	case FormatJSON:
+		return WriteJSONL(w, cues)
+	}
+	return fmt.Errorf("unsupported transcript format '%s'", format)
+}
+
+// WriteSRT writes the given cues as SubRip subtitles. SRT has no
+// notion of speakers, so each cue's speaker prefixes its text.
+// Cues without text are skipped, as SRT blocks must have text.
+func WriteSRT(w io.Writer, cues []Cue) error {
+	bw := bufio.NewWriter(w)
+	var n int
+	for _, c := range cues {
+		if strings.TrimSpace(c.Text) == "" {
+			continue
+		}
+		if n > 0 {
+			bw.WriteString("\n")
+		}
+		n++
+		fmt.Fprintf(bw, "%d\n%s --> %s\n%s\n", n, formatTimestamp(c.Start, ","), formatTimestamp(c.End, ","), speakerText(c))
+	}
+	return bw.Flush()
+}
+
+// WriteJSONL writes the given cues as JSON Lines
+func WriteJSONL(w io.Writer, cues []Cue) error {
+	enc := json.NewEncoder(w)
+	for _, c := range cues {
+		if err := enc.Encode(jsonCue{
+			ID:      c.ID,
+			Start:   c.Start.Seconds(),
+			End:     c.End.Seconds(),
+			Speaker: c.Speaker,
+			Text:    c.Text,
+		}); err != nil {
+			return fmt.Errorf("failed to encode cue: %w", err)
+		}
+	}
+	return nil
+}
+
+// WriteText writes the given cues as readable text, with consecutive
+// cues from the same speaker merged into a single paragraph.
+func WriteText(w io.Writer, cues []Cue) error {
+	bw := bufio.NewWriter(w)
+	for i, turn := range MergeSpeakerTurns(cues) {
+		if i > 0 {
+			bw.WriteString("\n")
+		}
+		fmt.Fprintf(bw, "[%s] %s\n", formatClock(turn.Start), speakerText(turn))
+	}
+	return bw.Flush()
+}
+
+// MergeSpeakerTurns merges consecutive cues from the same speaker into
+// a single cue spanning all of them, with their text joined by spaces.
+func MergeSpeakerTurns(cues []Cue) []Cue {
+	var turns []Cue
+	for _, c := range cues {
+		text := strings.Join(strings.Fields(c.Text), " ")
+		if l := len(turns); l > 0 && turns[l-1].Speaker == c.Speaker {
+			last := &turns[l-1]
+			last.End = c.End
+			last.Text = strings.TrimSpace(last.Text + " " + text)
+			continue
+		}
+		turns = append(turns, Cue{
+			Start:   c.Start,
+			End:     c.End,
+			Speaker: c.Speaker,
+			Text:    text,
+		})
+	}
+	return turns
+}
+
+func speakerText(c Cue) string {
+	if c.Speaker == "" {
+		return c.Text
+	}
+	return fmt.Sprintf("%s: %s", c.Speaker, c.Text)
+}
+
+// formatTimestamp formats d as "hh:mm:ss" followed by the
+// given fraction separator and milliseconds
+func formatTimestamp(d time.Duration, sep string) string {
+	return fmt.Sprintf("%s%s%03d", formatClock(d), sep, d.Milliseconds()%1000)
+}
+
+// formatClock formats d as "hh:mm:ss"
+func formatClock(d time.Duration) string {
+	s := int64(d / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", s/3600, s/60%60, s%60)
+}
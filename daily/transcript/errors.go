@@ -0,0 +1,21 @@
+package transcript
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrFailUnmarshal = errors.New("failed to unmarshal response body into Transcript")
+	// ErrInvalidVTT is returned when transcript
+	// content is not valid WebVTT.
+	ErrInvalidVTT = errors.New("invalid WebVTT content")
+)
+
+func NewErrFailUnmarshal(unmarshalErr error) error {
+	return fmt.Errorf("%s: %w", unmarshalErr, ErrFailUnmarshal)
+}
+
+func NewErrInvalidVTT(line int, err error) error {
+	return fmt.Errorf("line %d: %s: %w", line, err, ErrInvalidVTT)
+}
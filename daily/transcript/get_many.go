@@ -0,0 +1,88 @@
+package transcript
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/pagination"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type GetManyParams struct {
+	// Limit is the maximum number of transcripts to
+	// retrieve. If 0, all transcripts are retrieved.
+	Limit         int
+	StartingAfter string
+	// RoomID only retrieves transcripts of the given room
+	RoomID string
+	// MtgSessionID only retrieves transcripts of
+	// the given meeting session
+	MtgSessionID string
+}
+
+// GetMany returns transcripts matching the given params, if any.
+// If no params are given, all transcripts are returned.
+func GetMany(ctx context.Context, creds auth.Creds, params *GetManyParams) ([]Transcript, error) {
+	if params == nil {
+		params = &GetManyParams{}
+	}
+	if err := pagination.ValidateLimit(params.Limit); err != nil {
+		return nil, err
+	}
+	return pagination.Collect(params.Limit, params.StartingAfter, func(cursor string, limit int) (*pagination.Page[Transcript], error) {
+		return doGetTranscripts(ctx, creds, params, cursor, limit)
+	}, func(t Transcript) string {
+		return t.ID
+	})
+}
+
+func doGetTranscripts(ctx context.Context, creds auth.Creds, params *GetManyParams, cursor string, limit int) (*pagination.Page[Transcript], error) {
+	q := url.Values{}
+	pagination.SetQueryParams(q, cursor, limit)
+	if params.RoomID != "" {
+		q.Set("roomId", params.RoomID)
+	}
+	if params.MtgSessionID != "" {
+		q.Set("mtgSessionId", params.MtgSessionID)
+	}
+	endpoint, err := transcriptsEndpointWithParams(creds.APIURL, q)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request to transcripts endpoint: %w", err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transcripts: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+
+	var page pagination.Page[Transcript]
+	if err := json.Unmarshal(resBody, &page); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &page, nil
+}
@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"bytes"
+	"github.com/lazeratops/daily-go/daily/transcript"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+	cues := []transcript.Cue{
+		{
+			ID:      "1",
+			Start:   500 * time.Millisecond,
+			End:     2 * time.Second,
+			Speaker: "Alice",
+			Text:    "Hello everyone.",
+		},
+		{
+			ID:      "2",
+			Start:   2 * time.Second,
+			End:     4250 * time.Millisecond,
+			Speaker: "Alice",
+			Text:    "Shall we\nget started?",
+		},
+		{
+			ID:      "3",
+			Start:   time.Hour + 5*time.Second,
+			End:     time.Hour + 7*time.Second,
+			Speaker: "Bob",
+			Text:    "Sure.",
+		},
+		{
+			Start: time.Hour + 8*time.Second,
+			End:   time.Hour + 9*time.Second,
+			Text:  "(laughter)",
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		format  transcript.Format
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "srt",
+			format: transcript.FormatSRT,
+			want: `1
+00:00:00,500 --> 00:00:02,000
+Alice: Hello everyone.
+
+2
+00:00:02,000 --> 00:00:04,250
+Alice: Shall we
+get started?
+
+3
+01:00:05,000 --> 01:00:07,000
+Bob: Sure.
+
+4
+01:00:08,000 --> 01:00:09,000
+(laughter)
+`,
+		},
+		{
+			name:   "text",
+			format: transcript.FormatText,
+			want: `[00:00:00] Alice: Hello everyone. Shall we get started?
+
+[01:00:05] Bob: Sure.
+
+[01:00:08] (laughter)
+`,
+		},
+		{
+			name:   "json",
+			format: transcript.FormatJSON,
+			want: `{"id":"1","start":0.5,"end":2,"speaker":"Alice","text":"Hello everyone."}
+{"id":"2","start":2,"end":4.25,"speaker":"Alice","text":"Shall we\nget started?"}
+{"id":"3","start":3605,"end":3607,"speaker":"Bob","text":"Sure."}
+{"start":3608,"end":3609,"text":"(laughter)"}
+`,
+		},
+		{
+			name:    "unknown",
+			format:  "docx",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			gotErr := transcript.Export(&buf, cues, tc.format)
+			if tc.wantErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.want, buf.String())
+		})
+	}
+}
+
+func TestWriteSRTSkipsEmptyCues(t *testing.T) {
+	t.Parallel()
+	cues := []transcript.Cue{
+		{Start: time.Second, End: 2 * time.Second, Speaker: "Alice", Text: "Hi."},
+		{Start: 2 * time.Second, End: 3 * time.Second, Speaker: "Bob", Text: " \n"},
+		{Start: 3 * time.Second, End: 4 * time.Second, Speaker: "Bob", Text: "Hello."},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, transcript.WriteSRT(&buf, cues))
+	require.Equal(t, `1
+00:00:01,000 --> 00:00:02,000
+Alice: Hi.
+
+2
+00:00:03,000 --> 00:00:04,000
+Bob: Hello.
+`, buf.String())
+}
+
+func TestMergeSpeakerTurns(t *testing.T) {
+	t.Parallel()
+	gotTurns := transcript.MergeSpeakerTurns([]transcript.Cue{
+		{Start: 0, End: time.Second, Speaker: "Alice", Text: "One"},
+		{Start: time.Second, End: 2 * time.Second, Speaker: "Bob", Text: "Two"},
+		{Start: 2 * time.Second, End: 3 * time.Second, Speaker: "Bob", Text: "Three"},
+		{Start: 3 * time.Second, End: 4 * time.Second, Speaker: "Alice", Text: "Four"},
+	})
+	require.Equal(t, []transcript.Cue{
+		{Start: 0, End: time.Second, Speaker: "Alice", Text: "One"},
+		{Start: time.Second, End: 3 * time.Second, Speaker: "Bob", Text: "Two Three"},
+		{Start: 3 * time.Second, End: 4 * time.Second, Speaker: "Alice", Text: "Four"},
+	}, gotTurns)
+}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/transcript"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetOne(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name               string
+		dailyResStatusCode int
+		dailyResBody       string
+		wantTranscript     transcript.Transcript
+		wantErr            error
+	}{
+		{
+			name:               "bad status code",
+			dailyResStatusCode: http.StatusNotFound,
+			dailyResBody:       "{}",
+			wantErr:            errors.ErrFailedAPICall,
+		},
+		{
+			name:               "transcript retrieved",
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody: `
+				{
+					"transcriptId": "00dc8f18-a5b1-4a6c-8b3e-4b8b5a3c1d2e",
+					"domainId": "9ab8c1d2-3e4f-4a5b-8c6d-7e8f9a0b1c2d",
+					"roomId": "d61cd7b2-a273-42b4-89bd-be763fd562c1",
+					"mtgSessionId": "4b2e9c1e-0d5b-4f3a-8c7e-8a3b2f1e6d7c",
+					"status": "t_finished",
+					"isVttAvailable": true,
+					"duration": 125
+				}
+			`,
+			wantTranscript: transcript.Transcript{
+				ID:             "00dc8f18-a5b1-4a6c-8b3e-4b8b5a3c1d2e",
+				DomainID:       "9ab8c1d2-3e4f-4a5b-8c6d-7e8f9a0b1c2d",
+				RoomID:         "d61cd7b2-a273-42b4-89bd-be763fd562c1",
+				MtgSessionID:   "4b2e9c1e-0d5b-4f3a-8c7e-8a3b2f1e6d7c",
+				Status:         transcript.StatusFinished,
+				IsVTTAvailable: true,
+				Duration:       125,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/transcript/some-id", r.URL.Path)
+				w.WriteHeader(tc.dailyResStatusCode)
+				_, err := w.Write([]byte(tc.dailyResBody))
+				require.NoError(t, err)
+			}))
+
+			defer testServer.Close()
+
+			gotTranscript, gotErr := transcript.GetOne(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-id")
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			if gotErr == nil {
+				require.EqualValues(t, tc.wantTranscript, *gotTranscript)
+			}
+		})
+	}
+}
+
+func TestGetMany(t *testing.T) {
+	t.Parallel()
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		require.Equal(t, "/transcript", r.URL.Path)
+		require.Equal(t, "some-room-id", q.Get("roomId"))
+		require.Equal(t, "2", q.Get("limit"))
+
+		require.Equal(t, "t0", q.Get("starting_after"))
+		_, err := w.Write([]byte(`{"total_count":3,"data":[{"transcriptId":"t1"},{"transcriptId":"t2"}]}`))
+		require.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	gotTranscripts, gotErr := transcript.GetMany(context.Background(), auth.Creds{
+		APIKey: "someKey",
+		APIURL: testServer.URL,
+	}, &transcript.GetManyParams{
+		Limit:         2,
+		StartingAfter: "t0",
+		RoomID:        "some-room-id",
+	})
+	require.NoError(t, gotErr)
+	require.Len(t, gotTranscripts, 2)
+	require.Equal(t, "t1", gotTranscripts[0].ID)
+	require.Equal(t, "t2", gotTranscripts[1].ID)
+}
@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/transcript"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseVTT(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name     string
+		vtt      string
+		wantCues []transcript.Cue
+		wantErr  error
+	}{
+		{
+			name: "speakers and ids",
+			vtt: `WEBVTT
+
+NOTE This transcript was generated by Daily
+
+1
+00:00:00.500 --> 00:00:02.000
+<v Alice>Hello everyone.</v>
+
+2
+00:00:02.250 --> 00:01:03.125 align:start
+<v.host Bob Smith>Hi Alice,
+welcome back.
+
+00:59.000 --> 01:00:00.000
+No speaker here <b>at all</b>.
+`,
+			wantCues: []transcript.Cue{
+				{
+					ID:      "1",
+					Start:   500 * time.Millisecond,
+					End:     2 * time.Second,
+					Speaker: "Alice",
+					Text:    "Hello everyone.",
+				},
+				{
+					ID:      "2",
+					Start:   2250 * time.Millisecond,
+					End:     time.Minute + 3125*time.Millisecond,
+					Speaker: "Bob Smith",
+					Text:    "Hi Alice,\nwelcome back.",
+				},
+				{
+					Start: 59 * time.Second,
+					End:   time.Hour,
+					Text:  "No speaker here at all.",
+				},
+			},
+		},
+		{
+			name:     "byte order mark and CRLF",
+			vtt:      "\ufeffWEBVTT - header text\r\n\r\n00:00:01.000 --> 00:00:02.000\r\n<v Alice>Hi\r\n",
+			wantCues: []transcript.Cue{{Start: time.Second, End: 2 * time.Second, Speaker: "Alice", Text: "Hi"}},
+		},
+		{
+			name:    "missing signature",
+			vtt:     "00:00:01.000 --> 00:00:02.000\nHi\n",
+			wantErr: transcript.ErrInvalidVTT,
+		},
+		{
+			name:    "bad timestamp",
+			vtt:     "WEBVTT\n\n00:00:01 --> 00:00:02.000\nHi\n",
+			wantErr: transcript.ErrInvalidVTT,
+		},
+		{
+			name:    "ends before start",
+			vtt:     "WEBVTT\n\n00:00:03.000 --> 00:00:02.000\nHi\n",
+			wantErr: transcript.ErrInvalidVTT,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			gotCues, gotErr := transcript.ParseVTT(strings.NewReader(tc.vtt))
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantCues, gotCues)
+		})
+	}
+}
+
+func TestFetchCues(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name     string
+		retCode  int
+		retBody  string
+		wantCues []transcript.Cue
+		wantErr  error
+	}{
+		{
+			name:    "success",
+			retCode: http.StatusOK,
+			retBody: "WEBVTT\n\n00:00:01.000 --> 00:00:02.000\n<v Alice>Hi\n",
+			wantCues: []transcript.Cue{
+				{Start: time.Second, End: 2 * time.Second, Speaker: "Alice", Text: "Hi"},
+			},
+		},
+		{
+			name:    "bad status code",
+			retCode: http.StatusForbidden,
+			retBody: "AccessDenied",
+			wantErr: errors.ErrFailedAPICall,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.retCode)
+				_, err := w.Write([]byte(tc.retBody))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			gotCues, gotErr := transcript.FetchCues(context.Background(), testServer.URL)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			require.Equal(t, tc.wantCues, gotCues)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Package transcript handles Daily transcripts
+package transcript
+
+import (
+	"github.com/lazeratops/daily-go/daily/errors"
+	"net/url"
+	"path"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusInProgress Status = "t_in_progress"
+	StatusFinished   Status = "t_finished"
+	StatusError      Status = "t_error"
+)
+
+// Transcript represents the metadata of a Daily transcript
+type Transcript struct {
+	ID           string `json:"transcriptId"`
+	DomainID     string `json:"domainId"`
+	RoomID       string `json:"roomId"`
+	MtgSessionID string `json:"mtgSessionId"`
+	Status       Status `json:"status"`
+	// IsVTTAvailable reports whether the WebVTT
+	// content can be retrieved through an access link
+	IsVTTAvailable bool `json:"isVttAvailable"`
+	// Duration is in seconds, see GetDuration
+	Duration int `json:"duration"`
+}
+
+// GetDuration retrieves the length of the transcribed meeting
+func (t *Transcript) GetDuration() time.Duration {
+	return time.Duration(t.Duration) * time.Second
+}
+
+func transcriptsEndpointWithParams(apiURL string, query url.Values, paths ...string) (string, error) {
+	u, err := transcriptsURL(apiURL, paths...)
+	if err != nil {
+		return "", err
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+func transcriptsEndpoint(apiURL string, paths ...string) (string, error) {
+	u, err := transcriptsURL(apiURL, paths...)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func transcriptsURL(apiURL string, paths ...string) (*url.URL, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, errors.NewErrFailedEndpointConstruction(err)
+	}
+
+	allPaths := append([]string{u.Path, "transcript"}, paths...)
+	u.Path = path.Join(allPaths...)
+	return u, nil
+}
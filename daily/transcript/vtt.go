@@ -0,0 +1,229 @@
+package transcript
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	errors2 "github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cue is a single timed piece of transcribed speech
+type Cue struct {
+	// ID is the optional cue identifier
+	ID      string
+	Start   time.Duration
+	End     time.Duration
+	Speaker string
+	Text    string
+}
+
+var (
+	// voiceTagRegex matches a WebVTT voice span opening tag,
+	// e.g. "<v Alice>" or "<v.loud Alice>"
+	voiceTagRegex = regexp.MustCompile(`^<v(?:\.[^ \t>]*)?[ \t]+([^>]*)>`)
+	// tagRegex matches any other WebVTT cue text tag
+	tagRegex = regexp.MustCompile(`</?[^>]*>`)
+)
+
+// FetchCues downloads the WebVTT content at the given
+// transcript access link and parses it into cues
+func FetchCues(ctx context.Context, link string) ([]Cue, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", link, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request to transcript link: %w", err)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transcript content: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		resBody, _ := io.ReadAll(res.Body)
+		return nil, errors2.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+	return ParseVTT(res.Body)
+}
+
+// ParseVTT parses WebVTT content into cues. The speaker of each
+// cue is taken from its voice span, e.g. "<v Alice>Hello</v>".
+// Comment, style and region blocks are skipped.
+func ParseVTT(r io.Reader) ([]Cue, error) {
+	scanner := bufio.NewScanner(r)
+	var lineNum int
+	nextLine := func() (string, bool) {
+		if !scanner.Scan() {
+			return "", false
+		}
+		lineNum++
+		return strings.TrimRight(scanner.Text(), "\r"), true
+	}
+
+	// The file must start with the WEBVTT signature, optionally
+	// preceded by a byte order mark and followed by a header
+	header, ok := nextLine()
+	header = strings.TrimPrefix(header, "\ufeff")
+	if !ok || (header != "WEBVTT" && !strings.HasPrefix(header, "WEBVTT ") && !strings.HasPrefix(header, "WEBVTT\t")) {
+		return nil, NewErrInvalidVTT(1, errors.New("missing WEBVTT signature"))
+	}
+
+	var cues []Cue
+	var block []string
+	var blockStart int
+	flush := func() error {
+		defer func() { block = nil }()
+		if len(block) == 0 {
+			return nil
+		}
+		cue, isCue, err := parseBlock(block)
+		if err != nil {
+			return NewErrInvalidVTT(blockStart, err)
+		}
+		if isCue {
+			cues = append(cues, cue)
+		}
+		return nil
+	}
+
+	// Skip the rest of the header block
+	for {
+		line, ok := nextLine()
+		if !ok || line == "" {
+			break
+		}
+	}
+
+	for {
+		line, ok := nextLine()
+		if !ok {
+			break
+		}
+		if line == "" {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if len(block) == 0 {
+			blockStart = lineNum
+		}
+		block = append(block, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read WebVTT content: %w", err)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return cues, nil
+}
+
+// parseBlock parses a block of lines into a cue. It reports false
+// if the block is not a cue, such as a NOTE or STYLE block.
+func parseBlock(block []string) (Cue, bool, error) {
+	first := block[0]
+	if isNonCueBlock(first) {
+		return Cue{}, false, nil
+	}
+
+	var cue Cue
+	timingIdx := 0
+	if !strings.Contains(first, "-->") {
+		cue.ID = first
+		timingIdx = 1
+	}
+	if timingIdx >= len(block) {
+		return Cue{}, false, fmt.Errorf("cue '%s' has no timings", cue.ID)
+	}
+
+	start, end, err := parseTimings(block[timingIdx])
+	if err != nil {
+		return Cue{}, false, err
+	}
+	cue.Start = start
+	cue.End = end
+
+	text := strings.Join(block[timingIdx+1:], "\n")
+	if m := voiceTagRegex.FindStringSubmatch(text); m != nil {
+		cue.Speaker = strings.TrimSpace(m[1])
+		text = text[len(m[0]):]
+	}
+	cue.Text = strings.TrimSpace(tagRegex.ReplaceAllString(text, ""))
+	return cue, true, nil
+}
+
+func isNonCueBlock(firstLine string) bool {
+	for _, kw := range []string{"NOTE", "STYLE", "REGION"} {
+		if firstLine == kw || strings.HasPrefix(firstLine, kw+" ") || strings.HasPrefix(firstLine, kw+"\t") {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTimings parses a cue timings line such as
+// "00:00:01.000 --> 00:00:04.500 align:start"
+func parseTimings(line string) (time.Duration, time.Duration, error) {
+	parts := strings.SplitN(line, "-->", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid cue timings '%s'", line)
+	}
+	start, err := parseTimestamp(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, err
+	}
+	// Cue settings may follow the end timestamp
+	endFields := strings.Fields(parts[1])
+	if len(endFields) == 0 {
+		return 0, 0, fmt.Errorf("invalid cue timings '%s'", line)
+	}
+	end, err := parseTimestamp(endFields[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	if end < start {
+		return 0, 0, fmt.Errorf("cue ends before it starts: '%s'", line)
+	}
+	return start, end, nil
+}
+
+// parseTimestamp parses a WebVTT timestamp, "hh:mm:ss.ttt" or "mm:ss.ttt"
+func parseTimestamp(ts string) (time.Duration, error) {
+	invalid := fmt.Errorf("invalid timestamp '%s'", ts)
+
+	secParts := strings.SplitN(ts, ".", 2)
+	if len(secParts) != 2 || len(secParts[1]) != 3 {
+		return 0, invalid
+	}
+	millis, err := strconv.Atoi(secParts[1])
+	if err != nil {
+		return 0, invalid
+	}
+
+	units := strings.Split(secParts[0], ":")
+	if len(units) < 2 || len(units) > 3 {
+		return 0, invalid
+	}
+	var total time.Duration
+	for i, u := range units {
+		n, err := strconv.Atoi(u)
+		if err != nil || n < 0 {
+			return 0, invalid
+		}
+		// Minutes and seconds must be below 60, hours are unbounded
+		isHours := len(units) == 3 && i == 0
+		if !isHours && n >= 60 {
+			return 0, invalid
+		}
+		total = total*60 + time.Duration(n)*time.Second
+	}
+	return total + time.Duration(millis)*time.Millisecond, nil
+}
@@ -0,0 +1,57 @@
+package daily
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/webhook"
+)
+
+// CreateWebhook creates a Daily webhook subscription
+func (d *Daily) CreateWebhook(ctx context.Context, params webhook.CreateParams) (*webhook.Webhook, error) {
+	return webhook.Create(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, params)
+}
+
+// GetWebhooks returns all Daily webhook subscriptions
+func (d *Daily) GetWebhooks(ctx context.Context) ([]webhook.Webhook, error) {
+	return webhook.GetMany(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	})
+}
+
+// GetWebhook returns a single Daily webhook
+// subscription matching the given ID
+func (d *Daily) GetWebhook(ctx context.Context, webhookID string) (*webhook.Webhook, error) {
+	return webhook.GetOne(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, webhookID)
+}
+
+// UpdateWebhook changes the given fields of a Daily webhook subscription
+func (d *Daily) UpdateWebhook(ctx context.Context, webhookID string, params webhook.UpdateParams) (*webhook.Webhook, error) {
+	return webhook.Update(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, webhookID, params)
+}
+
+// ReactivateWebhook reactivates a failed Daily webhook subscription
+func (d *Daily) ReactivateWebhook(ctx context.Context, webhookID string) (*webhook.Webhook, error) {
+	return webhook.Reactivate(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, webhookID)
+}
+
+// DeleteWebhook deletes the Daily webhook
+// subscription with the given ID
+func (d *Daily) DeleteWebhook(ctx context.Context, webhookID string) error {
+	return webhook.Delete(ctx, auth.Creds{
+		APIKey: d.apiKey,
+		APIURL: d.apiURL,
+	}, webhookID)
+}
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+)
+
+type CreateParams struct {
+	// URL is the endpoint events are delivered to. Daily
+	// verifies it responds with a 200 before creating the webhook.
+	URL string
+	// EventTypes filters which events are delivered.
+	// If empty, all events are delivered.
+	EventTypes []EventType
+	// BasicAuth, if set, is sent as a basic auth
+	// token with each delivery
+	BasicAuth string
+	// HMAC is the base64-encoded secret deliveries are signed
+	// with. If empty, Daily generates one.
+	HMAC      string
+	RetryType RetryType
+}
+
+// Create creates a webhook subscription
+func Create(ctx context.Context, creds auth.Creds, params CreateParams) (*Webhook, error) {
+	if params.URL == "" {
+		return nil, NewErrInvalidParams(fmt.Errorf("URL is required"))
+	}
+	body := webhookBody{
+		URL:        params.URL,
+		BasicAuth:  params.BasicAuth,
+		HMAC:       params.HMAC,
+		RetryType:  params.RetryType,
+		EventTypes: params.EventTypes,
+	}
+	if err := body.validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := webhooksEndpoint(creds.APIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := doWebhookRequest(ctx, creds, "POST", endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalWebhook(resBody)
+}
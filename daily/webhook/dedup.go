@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"sync"
+	"time"
+)
+
+// Deduplicator remembers the IDs of handled events for a while,
+// so that events Daily delivers more than once are only handled once
+type Deduplicator struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	handled   map[string]time.Time
+	inFlight  map[string]struct{}
+	lastSweep time.Time
+}
+
+// NewDeduplicator returns a deduplicator remembering
+// handled events for the given duration
+func NewDeduplicator(ttl time.Duration) *Deduplicator {
+	return &Deduplicator{
+		ttl:      ttl,
+		handled:  make(map[string]time.Time),
+		inFlight: make(map[string]struct{}),
+	}
+}
+
+// claimResult is the outcome of claiming an event for handling
+type claimResult int
+
+const (
+	// claimOK means the event should be handled now
+	claimOK claimResult = iota
+	// claimHandled means the event was handled within the TTL
+	claimHandled
+	// claimInFlight means the event is being handled
+	// by another delivery
+	claimInFlight
+)
+
+// claim claims the event with the given ID for handling, unless it
+// was already handled or is being handled. Claimed events must be
+// released with done once handled.
+func (d *Deduplicator) claim(id string, now time.Time) claimResult {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.sweep(now)
+
+	if expiry, ok := d.handled[id]; ok && now.Before(expiry) {
+		return claimHandled
+	}
+	if _, ok := d.inFlight[id]; ok {
+		return claimInFlight
+	}
+	d.inFlight[id] = struct{}{}
+	return claimOK
+}
+
+// done releases a claimed event. Events which were not handled
+// successfully are forgotten, so a redelivery is handled again.
+func (d *Deduplicator) done(id string, now time.Time, handled bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.inFlight, id)
+	if handled {
+		d.handled[id] = now.Add(d.ttl)
+	}
+}
+
+// sweep forgets expired events, at most once per TTL
+func (d *Deduplicator) sweep(now time.Time) {
+	if now.Sub(d.lastSweep) < d.ttl {
+		return
+	}
+	for id, expiry := range d.handled {
+		if !now.Before(expiry) {
+			delete(d.handled, id)
+		}
+	}
+	d.lastSweep = now
+}
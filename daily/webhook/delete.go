@@ -0,0 +1,17 @@
+package webhook
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+)
+
+// Delete deletes the webhook with the given ID
+func Delete(ctx context.Context, creds auth.Creds, webhookID string) error {
+	endpoint, err := webhooksEndpoint(creds.APIURL, webhookID)
+	if err != nil {
+		return err
+	}
+
+	_, err = doWebhookRequest(ctx, creds, "DELETE", endpoint, nil)
+	return err
+}
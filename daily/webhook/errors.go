@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrFailUnmarshal = errors.New("failed to unmarshal response body into Webhook")
+	// ErrInvalidParams is returned when parameters given for a
+	// webhook operation are not accepted by Daily.
+	ErrInvalidParams = errors.New("invalid webhook params")
+	// ErrInvalidSecret is returned when a webhook
+	// HMAC secret is not valid base64.
+	ErrInvalidSecret = errors.New("invalid webhook secret")
+	// ErrInvalidSignature is returned when a delivery's
+	// signature does not match its content.
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	// ErrStaleTimestamp is returned when a delivery's timestamp
+	// is outside the handler's tolerance, e.g. because it is
+	// being replayed.
+	ErrStaleTimestamp       = errors.New("webhook timestamp outside tolerance")
+	ErrFailUnmarshalPayload = errors.New("failed to unmarshal webhook event payload")
+)
+
+func NewErrFailUnmarshal(unmarshalErr error) error {
+	return fmt.Errorf("%s: %w", unmarshalErr, ErrFailUnmarshal)
+}
+
+func NewErrInvalidParams(err error) error {
+	return fmt.Errorf("%s: %w", err, ErrInvalidParams)
+}
+
+func NewErrInvalidSecret(err error) error {
+	return fmt.Errorf("%s: %w", err, ErrInvalidSecret)
+}
+
+func NewErrInvalidSignature(err error) error {
+	return fmt.Errorf("%s: %w", err, ErrInvalidSignature)
+}
+
+func NewErrStaleTimestamp(age time.Duration) error {
+	return fmt.Errorf("timestamp is %s away from now: %w", age, ErrStaleTimestamp)
+}
+
+func NewErrFailUnmarshalPayload(eventType EventType, unmarshalErr error) error {
+	return fmt.Errorf("%s: %s: %w", eventType, unmarshalErr, ErrFailUnmarshalPayload)
+}
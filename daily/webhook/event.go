@@ -0,0 +1,181 @@
+package webhook
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+)
+
+// Event is a single webhook delivery from Daily
+type Event struct {
+	ID      string
+	Version string
+	Type    EventType
+	// Time is when Daily emitted the event
+	Time time.Time
+	// Payload is the type-specific event data, which
+	// can be decoded with DecodePayload
+	Payload json.RawMessage
+}
+
+// event is the wire representation of an Event
+type event struct {
+	ID      string          `json:"id"`
+	Version string          `json:"version"`
+	Type    EventType       `json:"type"`
+	Time    Timestamp       `json:"event_ts"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var ev event
+	if err := json.Unmarshal(data, &ev); err != nil {
+		return err
+	}
+	e.ID = ev.ID
+	e.Version = ev.Version
+	e.Type = ev.Type
+	e.Time = ev.Time.Time
+	e.Payload = ev.Payload
+	return nil
+}
+
+func (e Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal(event{
+		ID:      e.ID,
+		Version: e.Version,
+		Type:    e.Type,
+		Time:    Timestamp{e.Time},
+		Payload: e.Payload,
+	})
+}
+
+// DecodePayload decodes the payload of the given event into T
+func DecodePayload[T any](e Event) (T, error) {
+	var payload T
+	if err := json.Unmarshal(e.Payload, &payload); err != nil {
+		return payload, NewErrFailUnmarshalPayload(e.Type, err)
+	}
+	return payload, nil
+}
+
+// Timestamp is a time Daily reports as
+// fractional seconds since the epoch
+type Timestamp struct {
+	time.Time
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	var secs *float64
+	if err := json.Unmarshal(data, &secs); err != nil {
+		return err
+	}
+	if secs == nil {
+		t.Time = time.Time{}
+		return nil
+	}
+	whole, frac := math.Modf(*secs)
+	t.Time = time.Unix(int64(whole), int64(math.Round(frac*1e3))*int64(time.Millisecond))
+	return nil
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(float64(t.UnixMilli()) / 1e3)
+}
+
+// Seconds is a duration Daily reports in seconds
+type Seconds float64
+
+// Duration returns s as a time.Duration
+func (s Seconds) Duration() time.Duration {
+	return time.Duration(float64(s) * float64(time.Second))
+}
+
+// MeetingStarted is the payload of a meeting.started event
+type MeetingStarted struct {
+	MeetingID string    `json:"meeting_id"`
+	Room      string    `json:"room"`
+	StartTime Timestamp `json:"start_ts"`
+}
+
+// MeetingEnded is the payload of a meeting.ended event
+type MeetingEnded struct {
+	MeetingID string    `json:"meeting_id"`
+	Room      string    `json:"room"`
+	StartTime Timestamp `json:"start_ts"`
+	EndTime   Timestamp `json:"end_ts"`
+}
+
+// ParticipantJoined is the payload of a participant.joined event
+type ParticipantJoined struct {
+	// SessionID is the participant's session ID
+	SessionID   string    `json:"session_id"`
+	Room        string    `json:"room"`
+	UserID      string    `json:"user_id"`
+	UserName    string    `json:"user_name"`
+	Owner       bool      `json:"owner"`
+	JoinedAt    Timestamp `json:"joined_at"`
+	WillEjectAt Timestamp `json:"will_eject_at"`
+}
+
+// ParticipantLeft is the payload of a participant.left event
+type ParticipantLeft struct {
+	ParticipantJoined
+	Duration Seconds `json:"duration"`
+}
+
+// RecordingReadyToDownload is the payload of a
+// recording.ready-to-download event
+type RecordingReadyToDownload struct {
+	RecordingID     string    `json:"recording_id"`
+	Type            string    `json:"type"`
+	RoomName        string    `json:"room_name"`
+	StartTime       Timestamp `json:"start_ts"`
+	Status          string    `json:"status"`
+	MaxParticipants int       `json:"max_participants"`
+	Duration        Seconds   `json:"duration"`
+	S3Key           string    `json:"s3_key"`
+}
+
+// RecordingError is the payload of a recording.error event
+type RecordingError struct {
+	Action     string    `json:"action"`
+	ErrorMsg   string    `json:"error_msg"`
+	InstanceID string    `json:"instance_id"`
+	RoomName   string    `json:"room_name"`
+	Time       Timestamp `json:"timestamp"`
+}
+
+// TranscriptStarted is the payload of a transcript.started event
+type TranscriptStarted struct {
+	TranscriptID string    `json:"id"`
+	RoomID       string    `json:"room_id"`
+	RoomName     string    `json:"room_name"`
+	MtgSessionID string    `json:"mtg_session_id"`
+	InstanceID   string    `json:"instance_id"`
+	StartTime    Timestamp `json:"start_ts"`
+}
+
+// TranscriptReadyToDownload is the payload of a
+// transcript.ready-to-download event
+type TranscriptReadyToDownload struct {
+	TranscriptID string    `json:"id"`
+	RoomID       string    `json:"room_id"`
+	RoomName     string    `json:"room_name"`
+	MtgSessionID string    `json:"mtg_session_id"`
+	Status       string    `json:"status"`
+	StartTime    Timestamp `json:"start_ts"`
+	Duration     Seconds   `json:"duration"`
+}
+
+// TranscriptError is the payload of a transcript.error event
+type TranscriptError struct {
+	TranscriptID string `json:"id"`
+	RoomID       string `json:"room_id"`
+	RoomName     string `json:"room_name"`
+	MtgSessionID string `json:"mtg_session_id"`
+	Error        string `json:"error"`
+}
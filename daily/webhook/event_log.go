@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// maxLogLineSize is the longest event log line ReadEventLog accepts
+const maxLogLineSize = 4 * maxBodySize
+
+// Record is a single event delivery stored in an event log
+type Record struct {
+	ReceivedAt time.Time `json:"received_at"`
+	// Body is the delivered event JSON, exactly as received. It is
+	// stored base64-encoded rather than as JSON, which would be
+	// compacted and escaped, so replays match the original signature.
+	Body []byte `json:"body"`
+}
+
+// Event decodes the event stored in the record
+func (r Record) Event() (Event, error) {
+	var e Event
+	if err := json.Unmarshal(r.Body, &e); err != nil {
+		return Event{}, fmt.Errorf("failed to unmarshal logged event: %w", err)
+	}
+	return e, nil
+}
+
+// EventLog is an append-only JSON Lines file of event deliveries
+type EventLog struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+// OpenEventLog opens the event log at the given path for
+// appending, creating it if it does not exist yet
+func OpenEventLog(path string) (*EventLog, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event log: %w", err)
+	}
+	return &EventLog{f: f}, nil
+}
+
+// Append writes the given record to the end of the log and
+// syncs it to disk, so it survives the process crashing
+func (l *EventLog) Append(r Record) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event log record: %w", err)
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.f.Write(line); err != nil {
+		return fmt.Errorf("failed to write event log: %w", err)
+	}
+	if err := l.f.Sync(); err != nil {
+		return fmt.Errorf("failed to sync event log: %w", err)
+	}
+	return nil
+}
+
+// Close closes the log file
+func (l *EventLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
+
+// ReadEventLog reads all records from the given event log content
+func ReadEventLog(r io.Reader) ([]Record, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+
+	var records []Record
+	var lineNum int
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var rec Record
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return nil, fmt.Errorf("invalid event log record on line %d: %w", lineNum, err)
+		}
+		records = append(records, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read event log: %w", err)
+	}
+	return records, nil
+}
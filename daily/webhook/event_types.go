@@ -0,0 +1,23 @@
+package webhook
+
+// EventType is the type of event a webhook is delivered
+type EventType string
+
+const (
+	EventMeetingStarted            EventType = "meeting.started"
+	EventMeetingEnded              EventType = "meeting.ended"
+	EventParticipantJoined         EventType = "participant.joined"
+	EventParticipantLeft           EventType = "participant.left"
+	EventWaitingParticipantJoined  EventType = "waiting-participant.joined"
+	EventWaitingParticipantLeft    EventType = "waiting-participant.left"
+	EventRecordingStarted          EventType = "recording.started"
+	EventRecordingReadyToDownload  EventType = "recording.ready-to-download"
+	EventRecordingError            EventType = "recording.error"
+	EventTranscriptStarted         EventType = "transcript.started"
+	EventTranscriptReadyToDownload EventType = "transcript.ready-to-download"
+	EventTranscriptError           EventType = "transcript.error"
+	EventStreamingStarted          EventType = "streaming.started"
+	EventStreamingUpdated          EventType = "streaming.updated"
+	EventStreamingEnded            EventType = "streaming.ended"
+	EventStreamingError            EventType = "streaming.error"
+)
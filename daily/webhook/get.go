@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/lazeratops/daily-go/daily/auth"
+)
+
+// GetOne returns the webhook with the given ID
+func GetOne(ctx context.Context, creds auth.Creds, webhookID string) (*Webhook, error) {
+	endpoint, err := webhooksEndpoint(creds.APIURL, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := doWebhookRequest(ctx, creds, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalWebhook(resBody)
+}
+
+// GetMany returns all webhooks of the domain
+func GetMany(ctx context.Context, creds auth.Creds) ([]Webhook, error) {
+	endpoint, err := webhooksEndpoint(creds.APIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := doWebhookRequest(ctx, creds, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhooks []Webhook
+	if err := json.Unmarshal(resBody, &webhooks); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return webhooks, nil
+}
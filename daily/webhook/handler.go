@@ -0,0 +1,276 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTolerance = 5 * time.Minute
+	// maxBodySize is the largest delivery the handler accepts
+	maxBodySize = 1 << 20
+)
+
+// EventFunc handles a verified webhook event. Returning an error
+// responds to Daily with a 500, so the delivery is retried.
+type EventFunc func(ctx context.Context, e Event) error
+
+// HandlerOpts represents optional parameters for a webhook handler
+type HandlerOpts struct {
+	// Tolerance is how far a delivery's timestamp may be from
+	// the current time before it is rejected as a possible
+	// replay. Defaults to 5 minutes.
+	Tolerance time.Duration
+	// OnError, if set, is called with the reason for
+	// every delivery the handler does not accept.
+	OnError func(err error)
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+	// Dedup, if set, skips events which were already handled,
+	// responding to redeliveries without calling callbacks.
+	Dedup *Deduplicator
+	// EventLog, if set, has every verified event appended to
+	// it before callbacks are called. Skipped duplicates are
+	// not logged. Failing to log an event fails its delivery.
+	EventLog *EventLog
+}
+
+// Handler is an http.Handler which verifies Daily webhook deliveries
+// and passes the events they contain to registered callbacks
+type Handler struct {
+	key  []byte
+	opts HandlerOpts
+
+	mu        sync.RWMutex
+	callbacks map[EventType]EventFunc
+	onEvent   EventFunc
+}
+
+// NewHandler returns a handler verifying deliveries with the given
+// base64-encoded webhook HMAC secret. It answers Daily's verification
+// ping, which is sent when a webhook is created, without a signature.
+func NewHandler(secret string, opts *HandlerOpts) (*Handler, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	o := HandlerOpts{
+		Tolerance: defaultTolerance,
+		Now:       time.Now,
+	}
+	if opts != nil {
+		if opts.Tolerance > 0 {
+			o.Tolerance = opts.Tolerance
+		}
+		if opts.Now != nil {
+			o.Now = opts.Now
+		}
+		o.OnError = opts.OnError
+		o.Dedup = opts.Dedup
+		o.EventLog = opts.EventLog
+	}
+	return &Handler{
+		key:       key,
+		opts:      o,
+		callbacks: make(map[EventType]EventFunc),
+	}, nil
+}
+
+// rawBodyKey is the context key of the delivery body
+// passed to callbacks
+type rawBodyKey struct{}
+
+// RawBody returns the verified body of the delivery being handled,
+// exactly as Daily sent it, from the context passed to callbacks
+func RawBody(ctx context.Context) ([]byte, bool) {
+	body, ok := ctx.Value(rawBodyKey{}).([]byte)
+	return body, ok
+}
+
+// OnEvent registers a callback for every event, called
+// after the callback for the event's type, if any
+func (h *Handler) OnEvent(fn EventFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onEvent = fn
+}
+
+// OnEventType registers a callback for events of the given type,
+// replacing any callback previously registered for it
+func (h *Handler) OnEventType(eventType EventType, fn EventFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.callbacks[eventType] = fn
+}
+
+// OnMeetingStarted registers a callback for meeting.started events
+func (h *Handler) OnMeetingStarted(fn func(ctx context.Context, e Event, p MeetingStarted) error) {
+	h.OnEventType(EventMeetingStarted, typed(fn))
+}
+
+// OnMeetingEnded registers a callback for meeting.ended events
+func (h *Handler) OnMeetingEnded(fn func(ctx context.Context, e Event, p MeetingEnded) error) {
+	h.OnEventType(EventMeetingEnded, typed(fn))
+}
+
+// OnParticipantJoined registers a callback for participant.joined events
+func (h *Handler) OnParticipantJoined(fn func(ctx context.Context, e Event, p ParticipantJoined) error) {
+	h.OnEventType(EventParticipantJoined, typed(fn))
+}
+
+// OnParticipantLeft registers a callback for participant.left events
+func (h *Handler) OnParticipantLeft(fn func(ctx context.Context, e Event, p ParticipantLeft) error) {
+	h.OnEventType(EventParticipantLeft, typed(fn))
+}
+
+// OnRecordingReadyToDownload registers a callback
+// for recording.ready-to-download events
+func (h *Handler) OnRecordingReadyToDownload(fn func(ctx context.Context, e Event, p RecordingReadyToDownload) error) {
+	h.OnEventType(EventRecordingReadyToDownload, typed(fn))
+}
+
+// OnRecordingError registers a callback for recording.error events
+func (h *Handler) OnRecordingError(fn func(ctx context.Context, e Event, p RecordingError) error) {
+	h.OnEventType(EventRecordingError, typed(fn))
+}
+
+// OnTranscriptStarted registers a callback for transcript.started events
+func (h *Handler) OnTranscriptStarted(fn func(ctx context.Context, e Event, p TranscriptStarted) error) {
+	h.OnEventType(EventTranscriptStarted, typed(fn))
+}
+
+// OnTranscriptReadyToDownload registers a callback
+// for transcript.ready-to-download events
+func (h *Handler) OnTranscriptReadyToDownload(fn func(ctx context.Context, e Event, p TranscriptReadyToDownload) error) {
+	h.OnEventType(EventTranscriptReadyToDownload, typed(fn))
+}
+
+// OnTranscriptError registers a callback for transcript.error events
+func (h *Handler) OnTranscriptError(fn func(ctx context.Context, e Event, p TranscriptError) error) {
+	h.OnEventType(EventTranscriptError, typed(fn))
+}
+
+// typed wraps a callback taking a decoded payload as an EventFunc
+func typed[T any](fn func(ctx context.Context, e Event, p T) error) EventFunc {
+	return func(ctx context.Context, e Event) error {
+		payload, err := DecodePayload[T](e)
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, payload)
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.reject(w, http.StatusMethodNotAllowed, fmt.Errorf("unexpected method %s", r.Method))
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		h.reject(w, http.StatusBadRequest, fmt.Errorf("failed to read body: %w", err))
+		return
+	}
+
+	// Daily checks the endpoint responds before creating a webhook
+	if isVerificationPing(body) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err := verify(h.key, r.Header.Get(SignatureHeader), r.Header.Get(TimestampHeader), body, h.opts.Now(), h.opts.Tolerance); err != nil {
+		h.reject(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	var e Event
+	if err := json.Unmarshal(body, &e); err != nil {
+		h.reject(w, http.StatusBadRequest, fmt.Errorf("failed to unmarshal event: %w", err))
+		return
+	}
+
+	if dedup := h.opts.Dedup; dedup != nil && e.ID != "" {
+		switch dedup.claim(e.ID, h.opts.Now()) {
+		case claimHandled:
+			w.WriteHeader(http.StatusOK)
+			return
+		case claimInFlight:
+			// A concurrent delivery may yet fail, so have Daily retry later
+			h.reject(w, http.StatusConflict, fmt.Errorf("%s event %s is already being handled", e.Type, e.ID))
+			return
+		}
+		var handled bool
+		defer func() {
+			dedup.done(e.ID, h.opts.Now(), handled)
+		}()
+		handled = h.handle(w, r, e, body)
+		return
+	}
+	h.handle(w, r, e, body)
+}
+
+// handle logs and dispatches the given event, responds
+// accordingly and reports whether it was handled
+func (h *Handler) handle(w http.ResponseWriter, r *http.Request, e Event, body []byte) bool {
+	if h.opts.EventLog != nil {
+		if err := h.opts.EventLog.Append(Record{ReceivedAt: h.opts.Now(), Body: body}); err != nil {
+			h.reject(w, http.StatusInternalServerError, err)
+			return false
+		}
+	}
+
+	ctx := context.WithValue(r.Context(), rawBodyKey{}, body)
+	if err := h.dispatch(ctx, e); err != nil {
+		h.reject(w, http.StatusInternalServerError, fmt.Errorf("failed to handle %s event %s: %w", e.Type, e.ID, err))
+		return false
+	}
+	w.WriteHeader(http.StatusOK)
+	return true
+}
+
+func (h *Handler) dispatch(ctx context.Context, e Event) error {
+	h.mu.RLock()
+	fn := h.callbacks[e.Type]
+	onEvent := h.onEvent
+	h.mu.RUnlock()
+
+	if fn != nil {
+		if err := fn(ctx, e); err != nil {
+			return err
+		}
+	}
+	if onEvent != nil {
+		return onEvent(ctx, e)
+	}
+	return nil
+}
+
+func (h *Handler) reject(w http.ResponseWriter, status int, err error) {
+	if h.opts.OnError != nil {
+		h.opts.OnError(err)
+	}
+	// The reason is only reported to OnError, so callers
+	// learn nothing about why verification failed
+	http.Error(w, http.StatusText(status), status)
+}
+
+// isVerificationPing reports whether the given body is the
+// test request Daily sends when a webhook is created
+func isVerificationPing(body []byte) bool {
+	var ping struct {
+		Test string    `json:"test"`
+		Type EventType `json:"type"`
+	}
+	if err := json.Unmarshal(body, &ping); err != nil {
+		return false
+	}
+	return ping.Test != "" && ping.Type == ""
+}
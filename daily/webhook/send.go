@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Send delivers the given event body to the given URL the way Daily
+// does, signed with the given base64-encoded secret at the current time
+func Send(ctx context.Context, targetURL string, secret string, body []byte) error {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return err
+	}
+	timestamp := formatTimestamp(time.Now())
+
+	req, err := http.NewRequestWithContext(ctx, "POST", targetURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create POST request to webhook URL: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, sign(key, timestamp, body))
+	req.Header.Set(TimestampHeader, timestamp)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook event: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		resBody, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("failed to send webhook event: status code: %d; body: %s", res.StatusCode, string(resBody))
+	}
+	return nil
+}
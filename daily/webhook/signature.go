@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// SignatureHeader holds the signature of a delivery
+	SignatureHeader = "X-Webhook-Signature"
+	// TimestampHeader holds the time, in seconds since
+	// the epoch, a delivery was signed at
+	TimestampHeader = "X-Webhook-Timestamp"
+)
+
+// Sign returns the signature Daily sends for the given body and
+// timestamp: the base64-encoded HMAC-SHA256 of "<timestamp>.<body>",
+// keyed with the base64-decoded webhook secret.
+func Sign(secret string, timestamp time.Time, body []byte) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return sign(key, formatTimestamp(timestamp), body), nil
+}
+
+func sign(key []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verify checks the given signature and timestamp headers
+// against the body, rejecting timestamps further than
+// tolerance away from now.
+func verify(key []byte, signature string, timestamp string, body []byte, now time.Time, tolerance time.Duration) error {
+	secs, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return NewErrInvalidSignature(fmt.Errorf("invalid timestamp '%s'", timestamp))
+	}
+	age := now.Sub(time.Unix(secs, 0))
+	if age < -tolerance || age > tolerance {
+		return NewErrStaleTimestamp(age)
+	}
+
+	want := sign(key, timestamp, body)
+	if !hmac.Equal([]byte(want), []byte(signature)) {
+		return NewErrInvalidSignature(fmt.Errorf("signature does not match"))
+	}
+	return nil
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	if secret == "" {
+		return nil, NewErrInvalidSecret(fmt.Errorf("secret is empty"))
+	}
+	key, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, NewErrInvalidSecret(err)
+	}
+	return key, nil
+}
+
+func formatTimestamp(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
@@ -0,0 +1,189 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	testEventVersion = "1.0.0"
+	testRoomName     = "test-room"
+	testDomainName   = "test-domain"
+	testMeetingLen   = 30 * time.Minute
+)
+
+// NewTestEvent returns a realistic event of the given type, as Daily
+// would deliver it, for exercising webhook consumers without real
+// meetings. IDs are random and times are based on the current time.
+// Keys in overrides replace or add top-level payload fields.
+// The event can be delivered with Send.
+func NewTestEvent(eventType EventType, overrides map[string]interface{}) (Event, error) {
+	now := time.Now()
+	payload, err := testPayload(eventType, now)
+	if err != nil {
+		return Event{}, err
+	}
+	for k, v := range overrides {
+		payload[k] = v
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal test event payload: %w", err)
+	}
+	id, err := newUUID()
+	if err != nil {
+		return Event{}, err
+	}
+	return Event{
+		ID:      id,
+		Version: testEventVersion,
+		Type:    eventType,
+		Time:    now.Truncate(time.Millisecond),
+		Payload: data,
+	}, nil
+}
+
+// testPayload returns the default payload of the given event type
+func testPayload(eventType EventType, now time.Time) (map[string]interface{}, error) {
+	ids := make([]string, 5)
+	for i := range ids {
+		id, err := newUUID()
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	// Keep IDs which refer to the same thing consistent across payloads
+	sessionID, userID, roomID, instanceID, otherID := ids[0], ids[1], ids[2], ids[3], ids[4]
+	ts := func(t time.Time) float64 {
+		return float64(t.UnixMilli()) / 1e3
+	}
+	started := now.Add(-testMeetingLen)
+
+	switch eventType {
+	case EventMeetingStarted:
+		return map[string]interface{}{
+			"meeting_id": sessionID,
+			"room":       testRoomName,
+			"start_ts":   ts(now),
+		}, nil
+	case EventMeetingEnded:
+		return map[string]interface{}{
+			"meeting_id": sessionID,
+			"room":       testRoomName,
+			"start_ts":   ts(started),
+			"end_ts":     ts(now),
+		}, nil
+	case EventParticipantJoined, EventParticipantLeft:
+		payload := map[string]interface{}{
+			"room":       testRoomName,
+			"session_id": sessionID,
+			"user_id":    userID,
+			"user_name":  "Test User",
+			"owner":      false,
+			"joined_at":  ts(now),
+			"permissions": map[string]interface{}{
+				"hasPresence": true,
+				"canSend":     true,
+				"canAdmin":    false,
+			},
+		}
+		if eventType == EventParticipantLeft {
+			payload["joined_at"] = ts(started)
+			payload["duration"] = testMeetingLen.Seconds()
+		}
+		return payload, nil
+	case EventWaitingParticipantJoined, EventWaitingParticipantLeft:
+		return map[string]interface{}{
+			"room":      testRoomName,
+			"id":        sessionID,
+			"user_id":   userID,
+			"user_name": "Test User",
+			"joined_at": ts(now),
+		}, nil
+	case EventRecordingStarted:
+		return map[string]interface{}{
+			"action":       "cloud-recording-started",
+			"room_name":    testRoomName,
+			"instance_id":  instanceID,
+			"recording_id": otherID,
+			"start_ts":     ts(now),
+			"started_by":   sessionID,
+			"layout":       map[string]interface{}{"preset": "default"},
+		}, nil
+	case EventRecordingReadyToDownload:
+		return map[string]interface{}{
+			"type":             "cloud",
+			"recording_id":     otherID,
+			"room_name":        testRoomName,
+			"start_ts":         ts(started),
+			"status":           "finished",
+			"max_participants": 2,
+			"duration":         testMeetingLen.Seconds(),
+			"s3_key":           fmt.Sprintf("%s/%s/%d", testDomainName, testRoomName, started.UnixMilli()),
+		}, nil
+	case EventRecordingError:
+		return map[string]interface{}{
+			"action":      "cloud-recording-error",
+			"error_msg":   "test recording error",
+			"instance_id": instanceID,
+			"room_name":   testRoomName,
+			"timestamp":   ts(now),
+		}, nil
+	case EventTranscriptStarted:
+		return map[string]interface{}{
+			"id":             otherID,
+			"room_id":        roomID,
+			"room_name":      testRoomName,
+			"mtg_session_id": sessionID,
+			"instance_id":    instanceID,
+			"start_ts":       ts(now),
+		}, nil
+	case EventTranscriptReadyToDownload:
+		return map[string]interface{}{
+			"id":             otherID,
+			"room_id":        roomID,
+			"room_name":      testRoomName,
+			"mtg_session_id": sessionID,
+			"status":         "t_finished",
+			"start_ts":       ts(started),
+			"duration":       testMeetingLen.Seconds(),
+		}, nil
+	case EventTranscriptError:
+		return map[string]interface{}{
+			"id":             otherID,
+			"room_id":        roomID,
+			"room_name":      testRoomName,
+			"mtg_session_id": sessionID,
+			"error":          "test transcription error",
+		}, nil
+	case EventStreamingStarted, EventStreamingUpdated, EventStreamingEnded:
+		return map[string]interface{}{
+			"instance_id": instanceID,
+			"room_name":   testRoomName,
+			"timestamp":   ts(now),
+		}, nil
+	case EventStreamingError:
+		return map[string]interface{}{
+			"instance_id": instanceID,
+			"room_name":   testRoomName,
+			"error_msg":   "test streaming error",
+			"timestamp":   ts(now),
+		}, nil
+	}
+	return nil, NewErrInvalidParams(fmt.Errorf("no test event for event type '%s'", eventType))
+}
+
+// newUUID returns a random version 4 UUID
+func newUUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate UUID: %w", err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/lazeratops/daily-go/daily/webhook"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestHandlerDedup(t *testing.T) {
+	t.Parallel()
+	start := time.Unix(1708972280, 0)
+
+	type delivery struct {
+		// after is how long after the first delivery this one arrives
+		after          time.Duration
+		callbackErr    error
+		wantStatusCode int
+	}
+	testCases := []struct {
+		name          string
+		deliveries    []delivery
+		wantCallbacks int
+	}{
+		{
+			name: "redelivery is skipped",
+			deliveries: []delivery{
+				{wantStatusCode: http.StatusOK},
+				{after: time.Minute, wantStatusCode: http.StatusOK},
+			},
+			wantCallbacks: 1,
+		},
+		{
+			name: "failed delivery is retried",
+			deliveries: []delivery{
+				{callbackErr: errors.New("oops"), wantStatusCode: http.StatusInternalServerError},
+				{after: time.Minute, wantStatusCode: http.StatusOK},
+				{after: 2 * time.Minute, wantStatusCode: http.StatusOK},
+			},
+			wantCallbacks: 2,
+		},
+		{
+			name: "redelivery after TTL is handled",
+			deliveries: []delivery{
+				{wantStatusCode: http.StatusOK},
+				{after: time.Hour + time.Second, wantStatusCode: http.StatusOK},
+			},
+			wantCallbacks: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := start
+			h, err := webhook.NewHandler(secret, &webhook.HandlerOpts{
+				// Allow deliveries signed at the start throughout
+				Tolerance: 2 * time.Hour,
+				Now: func() time.Time {
+					return now
+				},
+				Dedup: webhook.NewDeduplicator(time.Hour),
+			})
+			require.NoError(t, err)
+
+			var gotCallbacks int
+			var callbackErr error
+			h.OnEvent(func(ctx context.Context, e webhook.Event) error {
+				gotCallbacks++
+				return callbackErr
+			})
+
+			sig, err := webhook.Sign(secret, start, []byte(participantJoinedBody))
+			require.NoError(t, err)
+			for i, d := range tc.deliveries {
+				now = start.Add(d.after)
+				callbackErr = d.callbackErr
+
+				req := httptest.NewRequest("POST", "/hook", bytes.NewBufferString(participantJoinedBody))
+				req.Header.Set(webhook.SignatureHeader, sig)
+				req.Header.Set(webhook.TimestampHeader, strconv.FormatInt(start.Unix(), 10))
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+				require.Equal(t, d.wantStatusCode, rec.Code, "delivery %d", i)
+			}
+			require.Equal(t, tc.wantCallbacks, gotCallbacks)
+		})
+	}
+}
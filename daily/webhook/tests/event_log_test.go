@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/webhook"
+	"github.com/stretchr/testify/require"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEventLogReplay(t *testing.T) {
+	t.Parallel()
+	logPath := filepath.Join(t.TempDir(), "events.jsonl")
+
+	// Record an event delivered to a handler
+	eventLog, err := webhook.OpenEventLog(logPath)
+	require.NoError(t, err)
+	recorder, err := webhook.NewHandler(secret, &webhook.HandlerOpts{EventLog: eventLog})
+	require.NoError(t, err)
+	recordServer := httptest.NewServer(recorder)
+	defer recordServer.Close()
+
+	require.NoError(t, webhook.Send(context.Background(), recordServer.URL, secret, []byte(participantJoinedBody)))
+	require.NoError(t, eventLog.Close())
+
+	f, err := os.Open(logPath)
+	require.NoError(t, err)
+	defer f.Close()
+	records, err := webhook.ReadEventLog(f)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.WithinDuration(t, time.Now(), records[0].ReceivedAt, time.Minute)
+	require.JSONEq(t, participantJoinedBody, string(records[0].Body))
+
+	// Replay it to another handler, which must accept the signature
+	receiver, err := webhook.NewHandler(secret, nil)
+	require.NoError(t, err)
+	var gotEvents []webhook.Event
+	receiver.OnEvent(func(ctx context.Context, e webhook.Event) error {
+		gotEvents = append(gotEvents, e)
+		return nil
+	})
+	replayServer := httptest.NewServer(receiver)
+	defer replayServer.Close()
+
+	require.NoError(t, webhook.Send(context.Background(), replayServer.URL, secret, records[0].Body))
+	require.Len(t, gotEvents, 1)
+	wantEvent, err := records[0].Event()
+	require.NoError(t, err)
+	require.Equal(t, wantEvent, gotEvents[0])
+
+	// Sending with the wrong secret is rejected
+	require.Error(t, webhook.Send(context.Background(), replayServer.URL, "b3RoZXI=", records[0].Body))
+}
+
+func TestEventLogKeepsBodyExact(t *testing.T) {
+	t.Parallel()
+	logPath := filepath.Join(t.TempDir(), "events.jsonl")
+
+	// Whitespace and HTML characters would not survive
+	// being stored as JSON, changing the signed bytes
+	const body = `{
+	"version": "1.0.0",
+	"type":    "meeting.started",
+	"id":      "mtg-start-<exact>&",
+	"payload": {"room": "a<b>&c",   "meeting_id": "mtg-1"},
+	"event_ts": 1708972279.961
+}
+`
+
+	eventLog, err := webhook.OpenEventLog(logPath)
+	require.NoError(t, err)
+	recorder, err := webhook.NewHandler(secret, &webhook.HandlerOpts{EventLog: eventLog})
+	require.NoError(t, err)
+	recordServer := httptest.NewServer(recorder)
+	defer recordServer.Close()
+
+	require.NoError(t, webhook.Send(context.Background(), recordServer.URL, secret, []byte(body)))
+	require.NoError(t, eventLog.Close())
+
+	f, err := os.Open(logPath)
+	require.NoError(t, err)
+	defer f.Close()
+	records, err := webhook.ReadEventLog(f)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, body, string(records[0].Body))
+}
+
+func TestReadEventLog(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name        string
+		content     string
+		wantRecords int
+		wantErr     bool
+	}{
+		{
+			name:        "empty",
+			content:     "",
+			wantRecords: 0,
+		},
+		{
+			name:        "blank lines are skipped",
+			content:     "{\"received_at\":\"2023-01-31T15:04:05Z\",\"body\":\"e30=\"}\n\n{\"received_at\":\"2023-01-31T15:04:06Z\",\"body\":\"e30=\"}\n",
+			wantRecords: 2,
+		},
+		{
+			name:    "invalid line",
+			content: "{\"received_at\":\"2023-01-31T15:04:05Z\",\"body\":\"e30=\"}\nnot json\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			records, err := webhook.ReadEventLog(strings.NewReader(tc.content))
+			if tc.wantErr {
+				require.ErrorContains(t, err, "line 2")
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, records, tc.wantRecords)
+		})
+	}
+}
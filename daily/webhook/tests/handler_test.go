@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/lazeratops/daily-go/daily/webhook"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// secret is base64 for "secret"
+const secret = "c2VjcmV0"
+
+const participantJoinedBody = `{
+	"version": "1.0.0",
+	"type": "participant.joined",
+	"id": "ptcpt-join-6497c79b-f326-4942-aef8-c36a29140ad1-1708972279961",
+	"payload": {
+		"room": "test",
+		"user_id": "6497c79b-f326-4942-aef8-c36a29140ad1",
+		"user_name": "testuser",
+		"session_id": "0c0d2dda-f21d-4cf9-ab56-86bf3c407ffa",
+		"joined_at": 1708972279.96,
+		"will_eject_at": 1708972299.541,
+		"owner": false
+	},
+	"event_ts": 1708972279.961
+}`
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+	now := time.Unix(1708972280, 0)
+
+	signedReq := func(t *testing.T, body string, signedAt time.Time, signWith string) *http.Request {
+		req := httptest.NewRequest("POST", "/hook", bytes.NewBufferString(body))
+		sig, err := webhook.Sign(signWith, signedAt, []byte(body))
+		require.NoError(t, err)
+		req.Header.Set(webhook.SignatureHeader, sig)
+		req.Header.Set(webhook.TimestampHeader, strconv.FormatInt(signedAt.Unix(), 10))
+		return req
+	}
+
+	testCases := []struct {
+		name           string
+		makeReq        func(t *testing.T) *http.Request
+		callbackErr    error
+		wantStatusCode int
+		wantErr        error
+		wantJoined     bool
+	}{
+		{
+			name: "verification ping",
+			makeReq: func(t *testing.T) *http.Request {
+				return httptest.NewRequest("POST", "/hook", bytes.NewBufferString(`{"test":"test"}`))
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "wrong method",
+			makeReq: func(t *testing.T) *http.Request {
+				return httptest.NewRequest("GET", "/hook", nil)
+			},
+			wantStatusCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name: "unsigned",
+			makeReq: func(t *testing.T) *http.Request {
+				return httptest.NewRequest("POST", "/hook", bytes.NewBufferString(participantJoinedBody))
+			},
+			wantStatusCode: http.StatusUnauthorized,
+			wantErr:        webhook.ErrInvalidSignature,
+		},
+		{
+			name: "signed with other secret",
+			makeReq: func(t *testing.T) *http.Request {
+				return signedReq(t, participantJoinedBody, now, "b3RoZXI=")
+			},
+			wantStatusCode: http.StatusUnauthorized,
+			wantErr:        webhook.ErrInvalidSignature,
+		},
+		{
+			name: "tampered body",
+			makeReq: func(t *testing.T) *http.Request {
+				req := signedReq(t, participantJoinedBody, now, secret)
+				req.Body = io.NopCloser(bytes.NewBufferString(participantJoinedBody + " "))
+				return req
+			},
+			wantStatusCode: http.StatusUnauthorized,
+			wantErr:        webhook.ErrInvalidSignature,
+		},
+		{
+			name: "replayed",
+			makeReq: func(t *testing.T) *http.Request {
+				return signedReq(t, participantJoinedBody, now.Add(-10*time.Minute), secret)
+			},
+			wantStatusCode: http.StatusUnauthorized,
+			wantErr:        webhook.ErrStaleTimestamp,
+		},
+		{
+			name: "callback fails",
+			makeReq: func(t *testing.T) *http.Request {
+				return signedReq(t, participantJoinedBody, now, secret)
+			},
+			callbackErr:    errors.New("database down"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantJoined:     true,
+		},
+		{
+			name: "event delivered",
+			makeReq: func(t *testing.T) *http.Request {
+				return signedReq(t, participantJoinedBody, now.Add(-time.Minute), secret)
+			},
+			wantStatusCode: http.StatusOK,
+			wantJoined:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotErr error
+			h, err := webhook.NewHandler(secret, &webhook.HandlerOpts{
+				Now: func() time.Time {
+					return now
+				},
+				OnError: func(err error) {
+					gotErr = err
+				},
+			})
+			require.NoError(t, err)
+
+			var gotJoined *webhook.ParticipantJoined
+			var gotEvents []webhook.Event
+			h.OnParticipantJoined(func(ctx context.Context, e webhook.Event, p webhook.ParticipantJoined) error {
+				gotJoined = &p
+				return tc.callbackErr
+			})
+			h.OnEvent(func(ctx context.Context, e webhook.Event) error {
+				gotEvents = append(gotEvents, e)
+				return nil
+			})
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, tc.makeReq(t))
+			require.Equal(t, tc.wantStatusCode, rec.Code)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, gotErr, tc.wantErr)
+			}
+			if !tc.wantJoined {
+				require.Nil(t, gotJoined)
+				require.Empty(t, gotEvents)
+				return
+			}
+
+			require.Equal(t, webhook.ParticipantJoined{
+				SessionID:   "0c0d2dda-f21d-4cf9-ab56-86bf3c407ffa",
+				Room:        "test",
+				UserID:      "6497c79b-f326-4942-aef8-c36a29140ad1",
+				UserName:    "testuser",
+				JoinedAt:    webhook.Timestamp{Time: time.UnixMilli(1708972279960)},
+				WillEjectAt: webhook.Timestamp{Time: time.UnixMilli(1708972299541)},
+			}, *gotJoined)
+			if tc.callbackErr != nil {
+				// The catch-all callback is skipped once the typed one fails
+				require.Empty(t, gotEvents)
+				return
+			}
+			require.Len(t, gotEvents, 1)
+			require.Equal(t, webhook.EventParticipantJoined, gotEvents[0].Type)
+			require.Equal(t, "ptcpt-join-6497c79b-f326-4942-aef8-c36a29140ad1-1708972279961", gotEvents[0].ID)
+			require.Equal(t, time.UnixMilli(1708972279961), gotEvents[0].Time)
+		})
+	}
+}
+
+func TestNewHandlerInvalidSecret(t *testing.T) {
+	t.Parallel()
+	_, err := webhook.NewHandler("not base64!", nil)
+	require.ErrorIs(t, err, webhook.ErrInvalidSecret)
+	_, err = webhook.NewHandler("", nil)
+	require.ErrorIs(t, err, webhook.ErrInvalidSecret)
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/lazeratops/daily-go/daily/webhook"
+	"github.com/stretchr/testify/require"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewTestEvent(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name      string
+		eventType webhook.EventType
+		overrides map[string]interface{}
+		register  func(h *webhook.Handler, got chan<- interface{})
+		check     func(t *testing.T, payload interface{})
+		wantErr   error
+	}{
+		{
+			name:      "meeting ended",
+			eventType: webhook.EventMeetingEnded,
+			register: func(h *webhook.Handler, got chan<- interface{}) {
+				h.OnMeetingEnded(func(ctx context.Context, e webhook.Event, p webhook.MeetingEnded) error {
+					got <- p
+					return nil
+				})
+			},
+			check: func(t *testing.T, payload interface{}) {
+				p := payload.(webhook.MeetingEnded)
+				require.NotEmpty(t, p.MeetingID)
+				require.Equal(t, 30*time.Minute, p.EndTime.Sub(p.StartTime.Time))
+			},
+		},
+		{
+			name:      "participant joined with overrides",
+			eventType: webhook.EventParticipantJoined,
+			overrides: map[string]interface{}{
+				"room":      "my-room",
+				"user_name": "Alice",
+				"owner":     true,
+			},
+			register: func(h *webhook.Handler, got chan<- interface{}) {
+				h.OnParticipantJoined(func(ctx context.Context, e webhook.Event, p webhook.ParticipantJoined) error {
+					got <- p
+					return nil
+				})
+			},
+			check: func(t *testing.T, payload interface{}) {
+				p := payload.(webhook.ParticipantJoined)
+				require.Equal(t, "my-room", p.Room)
+				require.Equal(t, "Alice", p.UserName)
+				require.True(t, p.Owner)
+				require.NotEmpty(t, p.SessionID)
+				require.WithinDuration(t, time.Now(), p.JoinedAt.Time, time.Minute)
+			},
+		},
+		{
+			name:      "participant left",
+			eventType: webhook.EventParticipantLeft,
+			register: func(h *webhook.Handler, got chan<- interface{}) {
+				h.OnParticipantLeft(func(ctx context.Context, e webhook.Event, p webhook.ParticipantLeft) error {
+					got <- p
+					return nil
+				})
+			},
+			check: func(t *testing.T, payload interface{}) {
+				p := payload.(webhook.ParticipantLeft)
+				require.NotEmpty(t, p.SessionID)
+				require.Equal(t, 30*time.Minute, p.Duration.Duration())
+			},
+		},
+		{
+			name:      "recording ready",
+			eventType: webhook.EventRecordingReadyToDownload,
+			register: func(h *webhook.Handler, got chan<- interface{}) {
+				h.OnRecordingReadyToDownload(func(ctx context.Context, e webhook.Event, p webhook.RecordingReadyToDownload) error {
+					got <- p
+					return nil
+				})
+			},
+			check: func(t *testing.T, payload interface{}) {
+				p := payload.(webhook.RecordingReadyToDownload)
+				require.NotEmpty(t, p.RecordingID)
+				require.Equal(t, "finished", p.Status)
+				require.NotEmpty(t, p.S3Key)
+			},
+		},
+		{
+			name:      "transcript error",
+			eventType: webhook.EventTranscriptError,
+			register: func(h *webhook.Handler, got chan<- interface{}) {
+				h.OnTranscriptError(func(ctx context.Context, e webhook.Event, p webhook.TranscriptError) error {
+					got <- p
+					return nil
+				})
+			},
+			check: func(t *testing.T, payload interface{}) {
+				p := payload.(webhook.TranscriptError)
+				require.NotEmpty(t, p.TranscriptID)
+				require.NotEmpty(t, p.Error)
+			},
+		},
+		{
+			name:      "unknown type",
+			eventType: "meeting.exploded",
+			wantErr:   webhook.ErrInvalidParams,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := webhook.NewTestEvent(tc.eventType, tc.overrides)
+			require.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			require.Equal(t, tc.eventType, e.Type)
+			require.NotEmpty(t, e.ID)
+
+			// Deliver the event end to end through a verifying handler
+			h, err := webhook.NewHandler(secret, nil)
+			require.NoError(t, err)
+			got := make(chan interface{}, 1)
+			tc.register(h, got)
+			testServer := httptest.NewServer(h)
+			defer testServer.Close()
+
+			body, err := json.Marshal(e)
+			require.NoError(t, err)
+			require.NoError(t, webhook.Send(context.Background(), testServer.URL, secret, body))
+			tc.check(t, <-got)
+		})
+	}
+}
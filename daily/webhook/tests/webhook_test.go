@@ -0,0 +1,280 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"github.com/lazeratops/daily-go/daily/webhook"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+const webhookBodyTmpl = `
+	{
+		"uuid": "0b4e4c7c-5eaf-46fe-990b-a3752f5eb4fa",
+		"url": "https://example.com/hook",
+		"hmac": "c2VjcmV0",
+		"basicAuth": "",
+		"retryType": "circuit-breaker",
+		"eventTypes": ["participant.joined", "participant.left"],
+		"state": "%s",
+		"failedCount": %d,
+		"lastMomentPushed": "2023-01-31T15:04:05.000Z",
+		"domainId": "16fe7ad0-5d4a-4bcb-83ee-5e9d1a6e3e9b",
+		"createdAt": "2023-01-30T10:00:00.000Z",
+		"updatedAt": "2023-01-31T15:04:05.000Z"
+	}
+`
+
+var (
+	activeWebhookBody = fmt.Sprintf(webhookBodyTmpl, "ACTIVE", 0)
+	failedWebhookBody = fmt.Sprintf(webhookBodyTmpl, "FAILED", 12)
+)
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name               string
+		params             webhook.CreateParams
+		wantReqBody        string
+		dailyResStatusCode int
+		dailyResBody       string
+		wantErr            error
+	}{
+		{
+			name:    "missing URL",
+			params:  webhook.CreateParams{},
+			wantErr: webhook.ErrInvalidParams,
+		},
+		{
+			name:    "relative URL",
+			params:  webhook.CreateParams{URL: "/hook"},
+			wantErr: webhook.ErrInvalidParams,
+		},
+		{
+			name: "unknown retry type",
+			params: webhook.CreateParams{
+				URL:       "https://example.com/hook",
+				RetryType: "forever",
+			},
+			wantErr: webhook.ErrInvalidParams,
+		},
+		{
+			name: "bad status code",
+			params: webhook.CreateParams{
+				URL: "https://example.com/hook",
+			},
+			wantReqBody:        `{"url":"https://example.com/hook"}`,
+			dailyResStatusCode: http.StatusBadRequest,
+			dailyResBody:       "{}",
+			wantErr:            errors.ErrFailedAPICall,
+		},
+		{
+			name: "webhook created",
+			params: webhook.CreateParams{
+				URL:        "https://example.com/hook",
+				EventTypes: []webhook.EventType{webhook.EventParticipantJoined, webhook.EventParticipantLeft},
+				BasicAuth:  "dXNlcjpwYXNz",
+				HMAC:       "c2VjcmV0",
+				RetryType:  webhook.RetryCircuitBreaker,
+			},
+			wantReqBody: `{
+				"url": "https://example.com/hook",
+				"eventTypes": ["participant.joined", "participant.left"],
+				"basicAuth": "dXNlcjpwYXNz",
+				"hmac": "c2VjcmV0",
+				"retryType": "circuit-breaker"
+			}`,
+			dailyResStatusCode: http.StatusOK,
+			dailyResBody:       activeWebhookBody,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "POST", r.Method)
+				require.Equal(t, "/webhooks", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.wantReqBody, string(body))
+				w.WriteHeader(tc.dailyResStatusCode)
+				_, err = w.Write([]byte(tc.dailyResBody))
+				require.NoError(t, err)
+			}))
+
+			defer testServer.Close()
+
+			gotWebhook, gotErr := webhook.Create(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, tc.params)
+			require.ErrorIs(t, gotErr, tc.wantErr)
+			if gotErr == nil {
+				require.Equal(t, webhook.Webhook{
+					ID:               "0b4e4c7c-5eaf-46fe-990b-a3752f5eb4fa",
+					URL:              "https://example.com/hook",
+					HMAC:             "c2VjcmV0",
+					RetryType:        webhook.RetryCircuitBreaker,
+					EventTypes:       []webhook.EventType{webhook.EventParticipantJoined, webhook.EventParticipantLeft},
+					State:            webhook.StateActive,
+					LastMomentPushed: time.Date(2023, 1, 31, 15, 4, 5, 0, time.UTC),
+					DomainID:         "16fe7ad0-5d4a-4bcb-83ee-5e9d1a6e3e9b",
+					CreatedAt:        time.Date(2023, 1, 30, 10, 0, 0, 0, time.UTC),
+					UpdatedAt:        time.Date(2023, 1, 31, 15, 4, 5, 0, time.UTC),
+				}, *gotWebhook)
+			}
+		})
+	}
+}
+
+func TestGetMany(t *testing.T) {
+	t.Parallel()
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "GET", r.Method)
+		require.Equal(t, "/webhooks", r.URL.Path)
+		_, err := fmt.Fprintf(w, `[%s, %s]`, activeWebhookBody, failedWebhookBody)
+		require.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	gotWebhooks, gotErr := webhook.GetMany(context.Background(), auth.Creds{
+		APIKey: "someKey",
+		APIURL: testServer.URL,
+	})
+	require.NoError(t, gotErr)
+	require.Len(t, gotWebhooks, 2)
+	require.Equal(t, webhook.StateActive, gotWebhooks[0].State)
+	require.Equal(t, webhook.StateFailed, gotWebhooks[1].State)
+	require.Equal(t, 12, gotWebhooks[1].FailedCount)
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		require.Equal(t, "/webhooks/some-id", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		// Fields which are not set are not sent
+		require.JSONEq(t, `{"eventTypes":["meeting.ended"]}`, string(body))
+		_, err = w.Write([]byte(activeWebhookBody))
+		require.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	_, gotErr := webhook.Update(context.Background(), auth.Creds{
+		APIKey: "someKey",
+		APIURL: testServer.URL,
+	}, "some-id", webhook.UpdateParams{
+		EventTypes: []webhook.EventType{webhook.EventMeetingEnded},
+	})
+	require.NoError(t, gotErr)
+}
+
+func TestReactivate(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name        string
+		currentBody string
+		wantUpdate  bool
+	}{
+		{
+			name:        "failed webhook is reactivated",
+			currentBody: failedWebhookBody,
+			wantUpdate:  true,
+		},
+		{
+			name:        "active webhook is left alone",
+			currentBody: activeWebhookBody,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var gotUpdate bool
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/webhooks/some-id", r.URL.Path)
+				if r.Method == "GET" {
+					_, err := w.Write([]byte(tc.currentBody))
+					require.NoError(t, err)
+					return
+				}
+				require.Equal(t, "POST", r.Method)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, `{"url":"https://example.com/hook"}`, string(body))
+				mu.Lock()
+				gotUpdate = true
+				mu.Unlock()
+				_, err = w.Write([]byte(activeWebhookBody))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			gotWebhook, gotErr := webhook.Reactivate(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-id")
+			require.NoError(t, gotErr)
+			require.Equal(t, webhook.StateActive, gotWebhook.State)
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, tc.wantUpdate, gotUpdate)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name               string
+		dailyResStatusCode int
+		wantErr            error
+	}{
+		{
+			name:               "not found",
+			dailyResStatusCode: http.StatusNotFound,
+			wantErr:            errors.ErrFailedAPICall,
+		},
+		{
+			name:               "deleted",
+			dailyResStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "DELETE", r.Method)
+				require.Equal(t, "/webhooks/some-id", r.URL.Path)
+				w.WriteHeader(tc.dailyResStatusCode)
+				_, err := w.Write([]byte(activeWebhookBody))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			gotErr := webhook.Delete(context.Background(), auth.Creds{
+				APIKey: "someKey",
+				APIURL: testServer.URL,
+			}, "some-id")
+			require.ErrorIs(t, gotErr, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"context"
+	"github.com/lazeratops/daily-go/daily/auth"
+)
+
+// UpdateParams represents the webhook fields to change.
+// Fields which are not set are left as they are.
+type UpdateParams struct {
+	URL        string
+	EventTypes []EventType
+	BasicAuth  string
+	HMAC       string
+	RetryType  RetryType
+}
+
+// Update changes the given fields of the webhook with the given
+// ID and returns the updated webhook. Updating a webhook in the
+// FAILED state also reactivates it.
+func Update(ctx context.Context, creds auth.Creds, webhookID string, params UpdateParams) (*Webhook, error) {
+	body := webhookBody{
+		URL:        params.URL,
+		BasicAuth:  params.BasicAuth,
+		HMAC:       params.HMAC,
+		RetryType:  params.RetryType,
+		EventTypes: params.EventTypes,
+	}
+	if err := body.validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := webhooksEndpoint(creds.APIURL, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := doWebhookRequest(ctx, creds, "POST", endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalWebhook(resBody)
+}
+
+// Reactivate puts a webhook in the FAILED state back into the
+// ACTIVE state by re-submitting its URL, which Daily verifies
+// again before resuming deliveries. Active webhooks are
+// returned as they are.
+func Reactivate(ctx context.Context, creds auth.Creds, webhookID string) (*Webhook, error) {
+	webhook, err := GetOne(ctx, creds, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if webhook.State == StateActive {
+		return webhook, nil
+	}
+	return Update(ctx, creds, webhookID, UpdateParams{URL: webhook.URL})
+}
@@ -0,0 +1,140 @@
+// Package webhook handles Daily webhook subscriptions
+// and the events delivered to them
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lazeratops/daily-go/daily/auth"
+	"github.com/lazeratops/daily-go/daily/errors"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+// State is the delivery state of a webhook
+type State string
+
+const (
+	// StateActive webhooks have events delivered to them
+	StateActive State = "ACTIVE"
+	// StateFailed webhooks have failed too many deliveries
+	// and no longer have events delivered until reactivated
+	StateFailed State = "FAILED"
+)
+
+// RetryType is how Daily retries failed deliveries
+type RetryType string
+
+const (
+	RetryCircuitBreaker RetryType = "circuit-breaker"
+	RetryExponential    RetryType = "exponential"
+)
+
+// Webhook represents a Daily webhook subscription
+type Webhook struct {
+	ID         string      `json:"uuid"`
+	URL        string      `json:"url"`
+	HMAC       string      `json:"hmac"`
+	BasicAuth  string      `json:"basicAuth"`
+	RetryType  RetryType   `json:"retryType"`
+	EventTypes []EventType `json:"eventTypes"`
+	State      State       `json:"state"`
+	// FailedCount is the number of consecutive failed deliveries
+	FailedCount      int       `json:"failedCount"`
+	LastMomentPushed time.Time `json:"lastMomentPushed"`
+	DomainID         string    `json:"domainId"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+// webhookBody is the request body for creating and updating
+// webhooks. Only fields which are set are sent.
+type webhookBody struct {
+	URL        string      `json:"url,omitempty"`
+	BasicAuth  string      `json:"basicAuth,omitempty"`
+	HMAC       string      `json:"hmac,omitempty"`
+	RetryType  RetryType   `json:"retryType,omitempty"`
+	EventTypes []EventType `json:"eventTypes,omitempty"`
+}
+
+func (b webhookBody) validate() error {
+	if b.URL != "" {
+		u, err := url.Parse(b.URL)
+		if err != nil {
+			return NewErrInvalidParams(fmt.Errorf("invalid URL '%s': %w", b.URL, err))
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return NewErrInvalidParams(fmt.Errorf("URL must be an absolute http(s) URL: '%s'", b.URL))
+		}
+	}
+	switch b.RetryType {
+	case "", RetryCircuitBreaker, RetryExponential:
+	default:
+		return NewErrInvalidParams(fmt.Errorf("unknown retry type '%s'", b.RetryType))
+	}
+	return nil
+}
+
+// doWebhookRequest sends the given body, if any, to the
+// given webhooks endpoint and returns the response body
+func doWebhookRequest(ctx context.Context, creds auth.Creds, method string, endpoint string, body interface{}) ([]byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		bodyBlob, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = bytes.NewBuffer(bodyBlob)
+	}
+
+	// Make the actual HTTP request
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s request to webhooks endpoint: %w", method, err)
+	}
+
+	// Prepare auth and content-type headers for request
+	auth.SetAPIKeyAuthHeaders(req, creds.APIKey)
+
+	// Do the thing!!!
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make webhooks request: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Parse the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewErrFailedBodyRead(err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.NewErrFailedAPICall(res.StatusCode, string(resBody))
+	}
+	return resBody, nil
+}
+
+func unmarshalWebhook(data []byte) (*Webhook, error) {
+	var webhook Webhook
+	if err := json.Unmarshal(data, &webhook); err != nil {
+		return nil, NewErrFailUnmarshal(err)
+	}
+	return &webhook, nil
+}
+
+func webhooksEndpoint(apiURL string, paths ...string) (string, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return "", errors.NewErrFailedEndpointConstruction(err)
+	}
+
+	allPaths := append([]string{u.Path, "webhooks"}, paths...)
+	u.Path = path.Join(allPaths...)
+	return u.String(), nil
+}